@@ -16,12 +16,20 @@ package debug
 
 import (
 	"bytes"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
 	"os"
+	"path/filepath"
 	"testing"
 
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/pluginpb"
 
 	"go.linka.cloud/protoc-gen-defaults/module"
 )
@@ -42,3 +50,51 @@ func TestDebugGen(t *testing.T) {
 		pgsgo.GoFmt(),
 	).Render()
 }
+
+// TestDebugCompiles checks that the generated code compiles as is, i.e. without goimports,
+// along the tests protobuf go files.
+func TestDebugCompiles(t *testing.T) {
+	require := require.New(t)
+	b, err := os.ReadFile("code_generator_request.pb.bin")
+	require.NoError(err)
+	req := &pluginpb.CodeGeneratorRequest{}
+	require.NoError(proto.Unmarshal(b, req))
+	req.Parameter = proto.String("paths=source_relative,errors=true,report=true,strip=true,diff=true,constructors=true,constants=true")
+	b, err = proto.Marshal(req)
+	require.NoError(err)
+	out := &bytes.Buffer{}
+	pgs.Init(
+		pgs.ProtocInput(bytes.NewReader(b)),
+		pgs.ProtocOutput(out),
+		pgs.DebugMode(),
+	).RegisterModule(
+		module.Defaults(),
+	).RegisterPostProcessor(
+		pgsgo.GoFmt(),
+	).Render()
+	res := &pluginpb.CodeGeneratorResponse{}
+	require.NoError(proto.Unmarshal(out.Bytes(), res))
+	require.Empty(res.GetError())
+	require.NotEmpty(res.GetFile())
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	names, err := filepath.Glob("../tests/pb/*.pb.go")
+	require.NoError(err)
+	for _, v := range names {
+		f, err := parser.ParseFile(fset, v, nil, 0)
+		require.NoError(err)
+		files = append(files, f)
+	}
+	for _, v := range res.GetFile() {
+		if filepath.Dir(v.GetName()) != "tests/pb" {
+			continue
+		}
+		f, err := parser.ParseFile(fset, v.GetName(), v.GetContent(), 0)
+		require.NoError(err)
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("pb", fset, files, nil)
+	require.NoError(err)
+}
@@ -16,228 +16,620 @@ package defaults
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 	reflect "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// Apply applies the defaults to m. The errors encountered are reported to ErrorHandler.
 func Apply(m proto.Message) {
-	if m == nil {
-		return
-	}
-	mref := m.ProtoReflect()
-	typd := mref.Type().Descriptor()
-	opts := typd.Options()
-	disabled := proto.GetExtension(opts, E_Disabled)
-	if disabled.(bool) {
-		return
-	}
-	ignored := proto.GetExtension(opts, E_Ignored)
-	if ignored.(bool) {
-		return
-	}
-	fields := typd.Fields()
-	for i := 0; i < fields.Len(); i++ {
-		f := fields.Get(i)
-		if f.IsList() || f.IsMap() {
+	if err := ApplyE(m); err != nil {
+		ErrorHandler(err)
+	}
+}
+
+// ApplyE applies the defaults to m and returns the errors encountered as Errors,
+// e.g. invalid values or rules not matching the field type.
+func ApplyE(m proto.Message) error {
+	return ApplyWithOptions(m)
+}
+
+func (s scope) apply(mref reflect.Message) Errors {
+	if s.exceeded() {
+		return nil
+	}
+	p := planOf(mref.Descriptor(), s.proto2)
+	if p.skip {
+		return nil
+	}
+	var errs Errors
+	for _, fp := range p.fields {
+		if fp.err != nil {
+			errs.Add(fp.name, fp.err)
 			continue
 		}
-		if mref.Has(f) {
+		if fp = fp.resolve(mref); fp == nil {
 			continue
 		}
-		v := proto.GetExtension(f.Options(), E_Value)
-		if v == nil {
-			continue
+		if fs, ok := s.field(fp.name); ok {
+			errs.Add(fp.name, fs.applyField(mref, fp))
 		}
-		fd, ok := v.(*FieldDefaults)
-		if !ok {
-			// wtf ???
-			continue
+	}
+	return errs
+}
+
+// applyField applies the defaults of fp to its field in mref, s being the scope of the field.
+func (s scope) applyField(mref reflect.Message, fp *fieldPlan) error {
+	f, fd := fp.fd, fp.rules
+	if f.IsMap() {
+		return s.applyMap(mref, fp)
+	}
+	if f.IsList() {
+		return s.applyList(mref, fp)
+	}
+	if oo := f.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+		if w := mref.WhichOneof(oo); w != nil && w != f {
+			return nil
 		}
-		name := f.Name()
-		if oo := f.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
-			v := proto.GetExtension(oo.Options(), E_Oneof)
-			oon, ok := v.(string)
-			if !ok {
-				// wtf ??
-				continue
+		if !mref.Has(f) && !fp.oneof {
+			return nil
+		}
+	}
+	if mref.Has(f) {
+		if md := fd.GetMessage(); md != nil && f.Kind() == reflect.MessageKind {
+			return s.applyMessage(mref.Mutable(f).Message(), md, messageDefaults(md), false)
+		}
+		if !s.override {
+			return nil
+		}
+	}
+	var n reflect.Value
+	if f.Kind() == reflect.MessageKind {
+		n = mref.NewField(f)
+		if md := fd.GetMessage(); md != nil {
+			if md.GetInitialize() && !s.noInit || md.GetLiteral() != nil {
+				s.reportSet()
+				err := s.applyMessage(n.Message(), md, messageDefaults(md), true)
+				mref.Set(f, n)
+				return err
 			}
-			if oon != string(name) {
-				continue
+			return nil
+		}
+	}
+	v, ok, err := s.fieldValue(mref, fp, n)
+	if err != nil {
+		return err
+	}
+	if ok {
+		mref.Set(f, v)
+		s.reportSet()
+	}
+	return nil
+}
+
+// messageDefaults reports whether the defaults of the message field described by md are applied:
+// unless disabled, as the generated Default methods do.
+func messageDefaults(md *MessageDefaults) bool {
+	return md.Defaults == nil || md.GetDefaults()
+}
+
+// applyMessage merges the literal of md into the unset fields of m, or all
+// its fields when overriding, then applies the defaults of m if defaults is true.
+// The new messages are reported as a whole, not the literal fields merged into them.
+func (s scope) applyMessage(m reflect.Message, md *MessageDefaults, defaults, isNew bool) error {
+	if md.GetLiteral() != nil {
+		v, err := messageValue(m, md, s.resolver)
+		if err != nil {
+			return err
+		}
+		if isNew {
+			proto.Merge(m.Interface(), v.Interface())
+		} else {
+			s.mergeUnset(m, v)
+		}
+	}
+	if defaults {
+		return s.apply(m).Err()
+	}
+	return nil
+}
+
+// messageValue returns a new message of the type of m described by the literal of md,
+// the extensions and Any types are resolved using r.
+func messageValue(m reflect.Message, md *MessageDefaults, r *protoregistry.Types) (reflect.Message, error) {
+	v := m.New()
+	var err error
+	switch l := md.GetLiteral().(type) {
+	case *MessageDefaults_Value:
+		err = prototext.UnmarshalOptions{Resolver: r}.Unmarshal([]byte(l.Value), v.Interface())
+	case *MessageDefaults_Json:
+		err = protojson.UnmarshalOptions{Resolver: r}.Unmarshal([]byte(l.Json), v.Interface())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// mergeUnset sets the fields of src which are unset in dst, or all of them when overriding,
+// recursing into the singular message fields set in both. The path of dst is the one of s.
+// The fields are walked in declaration order, as the generated code does, Range order being unstable,
+// the extensions following in number order.
+func (s scope) mergeUnset(dst, src reflect.Message) {
+	var fields []reflect.FieldDescriptor
+	src.Range(func(f reflect.FieldDescriptor, _ reflect.Value) bool {
+		fields = append(fields, f)
+		return true
+	})
+	sort.Slice(fields, func(i, j int) bool {
+		a, b := fields[i], fields[j]
+		if a.IsExtension() != b.IsExtension() {
+			return b.IsExtension()
+		}
+		if a.IsExtension() {
+			return a.Number() < b.Number()
+		}
+		return a.Index() < b.Index()
+	})
+	for _, f := range fields {
+		v := src.Get(f)
+		fs := s
+		fs.path = joinPath(s.path, string(f.Name()))
+		switch {
+		case dst.Has(f) && f.Kind() == reflect.MessageKind && f.Cardinality() != reflect.Repeated:
+			fs.mergeUnset(dst.Mutable(f).Message(), v.Message())
+		case dst.Has(f):
+			if s.override {
+				dst.Set(f, v)
+				fs.reportSet()
 			}
+		case f.ContainingOneof() != nil && !f.ContainingOneof().IsSynthetic() && dst.WhichOneof(f.ContainingOneof()) != nil:
+		default:
+			dst.Set(f, v)
+			fs.reportSet()
 		}
-		switch f.Kind() {
-		case reflect.BoolKind:
-			if _, ok := fd.GetType().(*FieldDefaults_Bool); !ok {
-				continue
+	}
+}
+
+func (s scope) applyList(m reflect.Message, fp *fieldPlan) error {
+	f, fd := fp.fd, fp.rules
+	var errs Errors
+	switch r := fd.GetType().(type) {
+	case *FieldDefaults_Repeated:
+		if m.Has(f) {
+			if !s.override {
+				return nil
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetBool()))
-		case reflect.EnumKind:
-			if _, ok := fd.GetType().(*FieldDefaults_Enum); !ok {
-				continue
+			m.Clear(f)
+		}
+		if len(fp.items) != 0 {
+			s.reportSet()
+		}
+		l := m.Mutable(f).List()
+		for i, v := range fp.items {
+			var n reflect.Value
+			if f.Kind() == reflect.MessageKind {
+				n = l.NewElement()
 			}
-			mref.Set(f, reflect.ValueOf(reflect.EnumNumber(fd.GetEnum())))
-		case reflect.Int32Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Int32); !ok {
+			v, ok, err := s.key(i).planValue(f, v, n)
+			if err != nil {
+				errs.AddKey("", i, err)
 				continue
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetInt32()))
-		case reflect.Sint32Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Sint32); !ok {
-				continue
+			if ok {
+				l.Append(v)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetSint32()))
-		case reflect.Uint32Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Uint32); !ok {
-				continue
+		}
+	case *FieldDefaults_Message:
+		if f.Kind() != reflect.MessageKind {
+			return ruleError(f, fd)
+		}
+		if !m.Has(f) {
+			return nil
+		}
+		l := m.Mutable(f).List()
+		for i := 0; i < l.Len(); i++ {
+			v := l.Get(i).Message()
+			isNew := !v.IsValid()
+			if isNew {
+				if !(r.Message.GetInitialize() && !s.noInit) && r.Message.GetLiteral() == nil {
+					continue
+				}
+				v = l.NewElement().Message()
+				l.Set(i, reflect.ValueOf(v))
+				s.key(i).reportSet()
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetUint32()))
-		case reflect.Int64Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Int64); !ok {
+			errs.AddKey("", i, s.key(i).applyMessage(v, r.Message, r.Message.GetDefaults(), isNew))
+		}
+	default:
+		return ruleError(f, fd)
+	}
+	return errs.Err()
+}
+
+func (s scope) applyMap(m reflect.Message, fp *fieldPlan) error {
+	f, fd := fp.fd, fp.rules
+	var errs Errors
+	var vd *MessageDefaults
+	switch r := fd.GetType().(type) {
+	case *FieldDefaults_Map:
+		errs = s.applyMapEntries(m, fp, r.Map)
+		vd = r.Map.GetValues()
+	case *FieldDefaults_Message:
+		vd = r.Message
+	default:
+		return ruleError(f, fd)
+	}
+	if vd == nil || !m.Has(f) {
+		return errs.Err()
+	}
+	if f.MapValue().Kind() != reflect.MessageKind {
+		errs.Add("", ruleError(f, fd))
+		return errs.Err()
+	}
+	mp := m.Mutable(f).Map()
+	var keys []reflect.MapKey
+	mp.Range(func(k reflect.MapKey, _ reflect.Value) bool {
+		keys = append(keys, k)
+		return true
+	})
+	for _, k := range keys {
+		v := mp.Get(k).Message()
+		isNew := !v.IsValid()
+		if isNew {
+			if !(vd.GetInitialize() && !s.noInit) && vd.GetLiteral() == nil {
 				continue
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetInt64()))
-		case reflect.Sint64Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Sint64); !ok {
-				continue
+			v = mp.NewValue().Message()
+			mp.Set(k, reflect.ValueOf(v))
+			s.key(k.Interface()).reportSet()
+		}
+		errs.AddKey("", k.Interface(), s.key(k.Interface()).applyMessage(v, vd, vd.GetDefaults(), isNew))
+	}
+	return errs.Err()
+}
+
+func (s scope) applyMapEntries(m reflect.Message, fp *fieldPlan, r *MapDefaults) Errors {
+	f := fp.fd
+	if len(r.GetEntries()) == 0 || m.Has(f) && !r.GetMerge() && !s.override {
+		return nil
+	}
+	if m.Has(f) && !r.GetMerge() {
+		m.Clear(f)
+	}
+	var errs Errors
+	mp := m.Mutable(f).Map()
+	for i := range r.GetEntries() {
+		k, ok, err := s.planValue(f.MapKey(), fp.keys[i], reflect.Value{})
+		if err != nil {
+			errs.AddKey("", i, fmt.Errorf("key: %w", err))
+			continue
+		}
+		if !ok || mp.Has(k.MapKey()) && !s.override {
+			continue
+		}
+		var n reflect.Value
+		if f.MapValue().Kind() == reflect.MessageKind {
+			n = mp.NewValue()
+		}
+		ks := s.key(k.Interface())
+		v, ok, err := ks.planValue(f.MapValue(), fp.values[i], n)
+		if err != nil {
+			errs.AddKey("", k.Interface(), err)
+			continue
+		}
+		if ok {
+			mp.Set(k.MapKey(), v)
+			ks.reportSet()
+		}
+	}
+	return errs
+}
+
+// ruleError returns the error reported when the rule of fd cannot be used for the field f.
+func ruleError(f reflect.FieldDescriptor, fd *FieldDefaults) error {
+	return fmt.Errorf("unexpected rule type %T for %v field", fd.GetType(), f.Kind())
+}
+
+// value returns the value described by fd for a single element of the field f.
+// For message fields, n must hold a new message of the field type.
+func (s scope) value(f reflect.FieldDescriptor, fd *FieldDefaults, n reflect.Value) (reflect.Value, bool, error) {
+	if r, ok := fd.GetType().(*FieldDefaults_Env); ok {
+		return envValue(f, r.Env, s.now)
+	}
+	if r, ok := fd.GetType().(*FieldDefaults_Generate); ok {
+		return generateValue(f, fd, r.Generate)
+	}
+	switch fd.GetType().(type) {
+	case *FieldDefaults_Expr:
+		return reflect.Value{}, false, fmt.Errorf("expr can only be used on singular fields outside of oneofs")
+	case *FieldDefaults_FromField:
+		return reflect.Value{}, false, fmt.Errorf("from_field can only be used on singular fields outside of oneofs")
+	}
+	switch f.Kind() {
+	case reflect.BoolKind:
+		if _, ok := fd.GetType().(*FieldDefaults_Bool); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetBool()), true, nil
+	case reflect.EnumKind:
+		switch r := fd.GetType().(type) {
+		case *FieldDefaults_Enum:
+			return reflect.ValueOf(reflect.EnumNumber(r.Enum)), true, nil
+		case *FieldDefaults_EnumName:
+			v := enumValue(f.Enum(), r.EnumName)
+			if v == nil {
+				return reflect.Value{}, false, fmt.Errorf("unknown %s value: %q", f.Enum().FullName(), r.EnumName)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetSint64()))
-		case reflect.Uint64Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Uint64); !ok {
-				continue
+			return reflect.ValueOf(v.Number()), true, nil
+		}
+		return reflect.Value{}, false, ruleError(f, fd)
+	case reflect.Int32Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Int32); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetInt32()), true, nil
+	case reflect.Sint32Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Sint32); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetSint32()), true, nil
+	case reflect.Uint32Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Uint32); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetUint32()), true, nil
+	case reflect.Int64Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Int64); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetInt64()), true, nil
+	case reflect.Sint64Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Sint64); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetSint64()), true, nil
+	case reflect.Uint64Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Uint64); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetUint64()), true, nil
+	case reflect.Sfixed32Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Sfixed32); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetSfixed32()), true, nil
+	case reflect.Fixed32Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Fixed32); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetFixed32()), true, nil
+	case reflect.FloatKind:
+		if _, ok := fd.GetType().(*FieldDefaults_Float); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetFloat()), true, nil
+	case reflect.Sfixed64Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Sfixed64); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetSfixed64()), true, nil
+	case reflect.Fixed64Kind:
+		if _, ok := fd.GetType().(*FieldDefaults_Fixed64); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetFixed64()), true, nil
+	case reflect.DoubleKind:
+		if _, ok := fd.GetType().(*FieldDefaults_Double); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetDouble()), true, nil
+	case reflect.StringKind:
+		if _, ok := fd.GetType().(*FieldDefaults_String_); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetString_()), true, nil
+	case reflect.BytesKind:
+		if _, ok := fd.GetType().(*FieldDefaults_Bytes); !ok {
+			return reflect.Value{}, false, ruleError(f, fd)
+		}
+		return reflect.ValueOf(fd.GetBytes()), true, nil
+	case reflect.MessageKind:
+		switch n.Message().Interface().(type) {
+		case *durationpb.Duration:
+			if _, ok := fd.GetType().(*FieldDefaults_Duration); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetUint64()))
-		case reflect.Sfixed32Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Sfixed32); !ok {
-				continue
+			d, err := model.ParseDuration(fd.GetDuration())
+			if err != nil {
+				return reflect.Value{}, false, err
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetSfixed32()))
-		case reflect.Fixed32Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Fixed32); !ok {
-				continue
+			return reflect.ValueOf(durationpb.New(time.Duration(d)).ProtoReflect()), true, nil
+		case *timestamppb.Timestamp:
+			if _, ok := fd.GetType().(*FieldDefaults_Timestamp); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetFixed32()))
-		case reflect.FloatKind:
-			if _, ok := fd.GetType().(*FieldDefaults_Float); !ok {
-				continue
+			ts := strings.TrimSpace(fd.GetTimestamp())
+			if strings.ToLower(ts) == "now" {
+				return reflect.ValueOf(timestamppb.New(s.now()).ProtoReflect()), true, nil
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetFloat()))
-		case reflect.Sfixed64Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Sfixed64); !ok {
-				continue
+			t, err := parseTime(ts)
+			if err != nil {
+				if t, err = RelativeTime(ts, s.now()); err != nil {
+					return reflect.Value{}, false, err
+				}
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetSfixed64()))
-		case reflect.Fixed64Kind:
-			if _, ok := fd.GetType().(*FieldDefaults_Fixed64); !ok {
-				continue
+			return reflect.ValueOf(timestamppb.New(t).ProtoReflect()), true, nil
+		case *anypb.Any:
+			if _, ok := fd.GetType().(*FieldDefaults_Any); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			v, err := anyValue(fd.GetAny(), s.resolver)
+			if err != nil {
+				return reflect.Value{}, false, err
+			}
+			return reflect.ValueOf(v.ProtoReflect()), true, nil
+		case *structpb.Struct, *structpb.Value, *structpb.ListValue:
+			if _, ok := fd.GetType().(*FieldDefaults_Json); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetFixed64()))
-		case reflect.DoubleKind:
+			if err := protojson.Unmarshal([]byte(fd.GetJson()), n.Message().Interface()); err != nil {
+				return reflect.Value{}, false, err
+			}
+			return n, true, nil
+		case *wrapperspb.DoubleValue:
 			if _, ok := fd.GetType().(*FieldDefaults_Double); !ok {
-				continue
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			return reflect.ValueOf(wrapperspb.Double(fd.GetDouble()).ProtoReflect()), true, nil
+		case *wrapperspb.FloatValue:
+			if _, ok := fd.GetType().(*FieldDefaults_Float); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			return reflect.ValueOf(wrapperspb.Float(fd.GetFloat()).ProtoReflect()), true, nil
+		case *wrapperspb.Int64Value:
+			if _, ok := fd.GetType().(*FieldDefaults_Int64); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			return reflect.ValueOf(wrapperspb.Int64(fd.GetInt64()).ProtoReflect()), true, nil
+		case *wrapperspb.UInt64Value:
+			if _, ok := fd.GetType().(*FieldDefaults_Uint64); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			return reflect.ValueOf(wrapperspb.UInt64(fd.GetUint64()).ProtoReflect()), true, nil
+		case *wrapperspb.Int32Value:
+			if _, ok := fd.GetType().(*FieldDefaults_Int32); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			return reflect.ValueOf(wrapperspb.Int32(fd.GetInt32()).ProtoReflect()), true, nil
+		case *wrapperspb.UInt32Value:
+			if _, ok := fd.GetType().(*FieldDefaults_Uint32); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetDouble()))
-		case reflect.StringKind:
+			return reflect.ValueOf(wrapperspb.UInt32(fd.GetUint32()).ProtoReflect()), true, nil
+		case *wrapperspb.BoolValue:
+			if _, ok := fd.GetType().(*FieldDefaults_Bool); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			return reflect.ValueOf(wrapperspb.Bool(fd.GetBool()).ProtoReflect()), true, nil
+		case *wrapperspb.StringValue:
 			if _, ok := fd.GetType().(*FieldDefaults_String_); !ok {
-				continue
+				return reflect.Value{}, false, ruleError(f, fd)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetString_()))
-		case reflect.BytesKind:
+			return reflect.ValueOf(wrapperspb.String(fd.GetString_()).ProtoReflect()), true, nil
+		case *wrapperspb.BytesValue:
 			if _, ok := fd.GetType().(*FieldDefaults_Bytes); !ok {
-				continue
+				return reflect.Value{}, false, ruleError(f, fd)
 			}
-			mref.Set(f, reflect.ValueOf(fd.GetBytes()))
-		case reflect.MessageKind:
-			m := fd.GetMessage()
-			switch mref.Get(f).Message().Interface().(type) {
-			case *durationpb.Duration:
-				if _, ok := fd.GetType().(*FieldDefaults_Duration); !ok {
-					continue
-				}
-				if d, err := model.ParseDuration(fd.GetDuration()); err == nil {
-					mref.Set(f, reflect.ValueOf(durationpb.New(time.Duration(d)).ProtoReflect()))
-				}
-			case *timestamppb.Timestamp:
-				if _, ok := fd.GetType().(*FieldDefaults_Timestamp); !ok {
-					continue
-				}
-				ts := fd.GetTimestamp()
-				if strings.ToLower(ts) == "now" {
-					mref.Set(f, reflect.ValueOf(timestamppb.Now().ProtoReflect()))
-					continue
-				}
-				if t, err := parseTime(ts); err == nil {
-					mref.Set(f, reflect.ValueOf(timestamppb.New(t).ProtoReflect()))
-				}
-			case *wrapperspb.DoubleValue:
-				if _, ok := fd.GetType().(*FieldDefaults_Double); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.Double(fd.GetDouble()).ProtoReflect()))
-			case *wrapperspb.FloatValue:
-				if _, ok := fd.GetType().(*FieldDefaults_Float); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.Float(fd.GetFloat()).ProtoReflect()))
-			case *wrapperspb.Int64Value:
-				if _, ok := fd.GetType().(*FieldDefaults_Int64); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.Int64(fd.GetInt64()).ProtoReflect()))
-			case *wrapperspb.UInt64Value:
-				if _, ok := fd.GetType().(*FieldDefaults_Uint64); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.UInt64(fd.GetUint64()).ProtoReflect()))
-			case *wrapperspb.Int32Value:
-				if _, ok := fd.GetType().(*FieldDefaults_Int32); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.Int32(fd.GetInt32()).ProtoReflect()))
-			case *wrapperspb.UInt32Value:
-				if _, ok := fd.GetType().(*FieldDefaults_Uint32); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.UInt32(fd.GetUint32()).ProtoReflect()))
-			case *wrapperspb.BoolValue:
-				if _, ok := fd.GetType().(*FieldDefaults_Bool); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.Bool(fd.GetBool()).ProtoReflect()))
-			case *wrapperspb.StringValue:
-				if _, ok := fd.GetType().(*FieldDefaults_String_); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.String(fd.GetString_()).ProtoReflect()))
-			case *wrapperspb.BytesValue:
-				if _, ok := fd.GetType().(*FieldDefaults_Bytes); !ok {
-					continue
-				}
-				mref.Set(f, reflect.ValueOf(wrapperspb.Bytes(fd.GetBytes()).ProtoReflect()))
-			default:
-				if _, ok := fd.GetType().(*FieldDefaults_Message); !ok {
-					continue
-				}
-				if !mref.Get(f).Message().IsValid() {
-					if !m.GetInitialize() {
-						continue
-					}
-					mref.Set(f, reflect.ValueOf(mref.Get(f).Message().New()))
-				}
-				if !m.GetDefaults() {
-					continue
-				}
-				Apply(mref.Get(f).Message().Interface())
+			return reflect.ValueOf(wrapperspb.Bytes(fd.GetBytes()).ProtoReflect()), true, nil
+		default:
+			if _, ok := fd.GetType().(*FieldDefaults_Message); !ok {
+				return reflect.Value{}, false, ruleError(f, fd)
+			}
+			if err := s.applyMessage(n.Message(), fd.GetMessage(), fd.GetMessage().GetDefaults(), true); err != nil {
+				return reflect.Value{}, false, err
 			}
-		case reflect.GroupKind:
+			return n, true, nil
+		}
+	}
+	return reflect.Value{}, false, ruleError(f, fd)
+}
+
+// generateValue returns the value of the string or bytes field f generated by r.
+func generateValue(f reflect.FieldDescriptor, fd *FieldDefaults, r *GenerateDefaults) (reflect.Value, bool, error) {
+	bytes := f.Kind() == reflect.BytesKind
+	switch {
+	case f.Kind() == reflect.StringKind, bytes:
+	case f.Kind() == reflect.MessageKind && f.Message().FullName() == "google.protobuf.StringValue":
+	case f.Kind() == reflect.MessageKind && f.Message().FullName() == "google.protobuf.BytesValue":
+		bytes = true
+	default:
+		return reflect.Value{}, false, ruleError(f, fd)
+	}
+	var v interface{}
+	var err error
+	if bytes {
+		v, err = GenerateBytes(r.GetType(), r.GetLength())
+	} else {
+		v, err = Generate(r.GetType(), r.GetLength())
+	}
+	if err != nil {
+		return reflect.Value{}, false, err
+	}
+	switch f.Kind() {
+	case reflect.MessageKind:
+		if bytes {
+			return reflect.ValueOf(wrapperspb.Bytes(v.([]byte)).ProtoReflect()), true, nil
 		}
+		return reflect.ValueOf(wrapperspb.String(v.(string)).ProtoReflect()), true, nil
+	}
+	return reflect.ValueOf(v), true, nil
+}
+
+// anyValue returns the google.protobuf.Any described by r,
+// the packed message type is resolved using types.
+func anyValue(r *AnyDefaults, types *protoregistry.Types) (*anypb.Any, error) {
+	url := strings.TrimSpace(r.GetTypeUrl())
+	if url == "" {
+		return nil, errors.New("missing type url")
+	}
+	if !strings.Contains(url, "/") {
+		url = "type.googleapis.com/" + strings.TrimPrefix(url, ".")
+	}
+	mt, err := types.FindMessageByURL(url)
+	if err != nil {
+		return nil, err
+	}
+	msg := mt.New().Interface()
+	switch v := r.GetValue().(type) {
+	case *AnyDefaults_Text:
+		err = prototext.UnmarshalOptions{Resolver: types}.Unmarshal([]byte(v.Text), msg)
+	case *AnyDefaults_Json:
+		err = protojson.UnmarshalOptions{Resolver: types}.Unmarshal([]byte(v.Json), msg)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return &anypb.Any{TypeUrl: url, Value: b}, nil
+}
+
+// enumValue returns the value of e matching either the name or the fully
+// qualified name n, or nil if no value matches. The fully qualified name
+// may or may not include the enum name, e.g. pkg.Message.VALUE or pkg.Message.Enum.VALUE.
+func enumValue(e reflect.EnumDescriptor, n string) reflect.EnumValueDescriptor {
+	n = strings.TrimPrefix(strings.TrimSpace(n), ".")
+	if v := e.Values().ByName(reflect.Name(n)); v != nil {
+		return v
+	}
+	i := strings.LastIndex(n, ".")
+	if i < 0 {
+		return nil
+	}
+	v := e.Values().ByName(reflect.Name(n[i+1:]))
+	if v == nil {
+		return nil
+	}
+	if n[:i] != string(e.FullName()) && n != string(v.FullName()) {
+		return nil
 	}
+	return v
 }
 
 func parseTime(s string) (time.Time, error) {
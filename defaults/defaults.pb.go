@@ -36,6 +36,88 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Generator defines how a dynamic value is generated.
+type Generator int32
+
+const (
+	Generator_GENERATOR_UNSPECIFIED Generator = 0
+	// UUID_V4 is a random UUID, e.g. 0b3f4a5e-7c1d-4f2a-9b8e-1a2b3c4d5e6f.
+	Generator_UUID_V4 Generator = 1
+	// UUID_V7 is a time-ordered UUID.
+	Generator_UUID_V7 Generator = 2
+	// ULID is a Universally Unique Lexicographically Sortable Identifier.
+	Generator_ULID Generator = 3
+	// XID is a globally unique id, see https://github.com/rs/xid.
+	Generator_XID Generator = 4
+	// OBJECT_ID is a MongoDB ObjectId.
+	Generator_OBJECT_ID Generator = 5
+	// RANDOM_HEX is a random hex encoded value of length random bytes.
+	Generator_RANDOM_HEX Generator = 6
+	// HOSTNAME is the host name reported by the kernel.
+	Generator_HOSTNAME Generator = 7
+)
+
+// Enum value maps for Generator.
+var (
+	Generator_name = map[int32]string{
+		0: "GENERATOR_UNSPECIFIED",
+		1: "UUID_V4",
+		2: "UUID_V7",
+		3: "ULID",
+		4: "XID",
+		5: "OBJECT_ID",
+		6: "RANDOM_HEX",
+		7: "HOSTNAME",
+	}
+	Generator_value = map[string]int32{
+		"GENERATOR_UNSPECIFIED": 0,
+		"UUID_V4":               1,
+		"UUID_V7":               2,
+		"ULID":                  3,
+		"XID":                   4,
+		"OBJECT_ID":             5,
+		"RANDOM_HEX":            6,
+		"HOSTNAME":              7,
+	}
+)
+
+func (x Generator) Enum() *Generator {
+	p := new(Generator)
+	*p = x
+	return p
+}
+
+func (x Generator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Generator) Descriptor() protoreflect.EnumDescriptor {
+	return file_defaults_defaults_proto_enumTypes[0].Descriptor()
+}
+
+func (Generator) Type() protoreflect.EnumType {
+	return &file_defaults_defaults_proto_enumTypes[0]
+}
+
+func (x Generator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Generator) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Generator(num)
+	return nil
+}
+
+// Deprecated: Use Generator.Descriptor instead.
+func (Generator) EnumDescriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{0}
+}
+
 // FieldDefaults encapsulates the default values for each type of field. Depending on the
 // field, the correct set should be used to ensure proper defaults generation.
 type FieldDefaults struct {
@@ -62,8 +144,17 @@ type FieldDefaults struct {
 	//	*FieldDefaults_Bytes
 	//	*FieldDefaults_Enum
 	//	*FieldDefaults_Message
+	//	*FieldDefaults_Repeated
+	//	*FieldDefaults_Map
+	//	*FieldDefaults_Any
 	//	*FieldDefaults_Duration
 	//	*FieldDefaults_Timestamp
+	//	*FieldDefaults_EnumName
+	//	*FieldDefaults_Json
+	//	*FieldDefaults_Generate
+	//	*FieldDefaults_Env
+	//	*FieldDefaults_Expr
+	//	*FieldDefaults_FromField
 	Type isFieldDefaults_Type `protobuf_oneof:"type"`
 }
 
@@ -225,6 +316,27 @@ func (x *FieldDefaults) GetMessage() *MessageDefaults {
 	return nil
 }
 
+func (x *FieldDefaults) GetRepeated() *RepeatedDefaults {
+	if x, ok := x.GetType().(*FieldDefaults_Repeated); ok {
+		return x.Repeated
+	}
+	return nil
+}
+
+func (x *FieldDefaults) GetMap() *MapDefaults {
+	if x, ok := x.GetType().(*FieldDefaults_Map); ok {
+		return x.Map
+	}
+	return nil
+}
+
+func (x *FieldDefaults) GetAny() *AnyDefaults {
+	if x, ok := x.GetType().(*FieldDefaults_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
 func (x *FieldDefaults) GetDuration() string {
 	if x, ok := x.GetType().(*FieldDefaults_Duration); ok {
 		return x.Duration
@@ -239,6 +351,48 @@ func (x *FieldDefaults) GetTimestamp() string {
 	return ""
 }
 
+func (x *FieldDefaults) GetEnumName() string {
+	if x, ok := x.GetType().(*FieldDefaults_EnumName); ok {
+		return x.EnumName
+	}
+	return ""
+}
+
+func (x *FieldDefaults) GetJson() string {
+	if x, ok := x.GetType().(*FieldDefaults_Json); ok {
+		return x.Json
+	}
+	return ""
+}
+
+func (x *FieldDefaults) GetGenerate() *GenerateDefaults {
+	if x, ok := x.GetType().(*FieldDefaults_Generate); ok {
+		return x.Generate
+	}
+	return nil
+}
+
+func (x *FieldDefaults) GetEnv() string {
+	if x, ok := x.GetType().(*FieldDefaults_Env); ok {
+		return x.Env
+	}
+	return ""
+}
+
+func (x *FieldDefaults) GetExpr() string {
+	if x, ok := x.GetType().(*FieldDefaults_Expr); ok {
+		return x.Expr
+	}
+	return ""
+}
+
+func (x *FieldDefaults) GetFromField() string {
+	if x, ok := x.GetType().(*FieldDefaults_FromField); ok {
+		return x.FromField
+	}
+	return ""
+}
+
 type isFieldDefaults_Type interface {
 	isFieldDefaults_Type()
 }
@@ -313,9 +467,20 @@ type FieldDefaults_Message struct {
 	Message *MessageDefaults `protobuf:"bytes,17,opt,name=message,oneof"`
 }
 
-type FieldDefaults_Duration struct {
+type FieldDefaults_Repeated struct {
+	Repeated *RepeatedDefaults `protobuf:"bytes,18,opt,name=repeated,oneof"`
+}
+
+type FieldDefaults_Map struct {
+	Map *MapDefaults `protobuf:"bytes,19,opt,name=map,oneof"`
+}
+
+type FieldDefaults_Any struct {
 	// Well-Known Field Types
-	// any       = 20;
+	Any *AnyDefaults `protobuf:"bytes,20,opt,name=any,oneof"`
+}
+
+type FieldDefaults_Duration struct {
 	Duration string `protobuf:"bytes,21,opt,name=duration,oneof"`
 }
 
@@ -323,6 +488,42 @@ type FieldDefaults_Timestamp struct {
 	Timestamp string `protobuf:"bytes,22,opt,name=timestamp,oneof"`
 }
 
+type FieldDefaults_EnumName struct {
+	// Enum value name, either the value name or its fully qualified name
+	EnumName string `protobuf:"bytes,23,opt,name=enum_name,json=enumName,oneof"`
+}
+
+type FieldDefaults_Json struct {
+	// JSON value for google.protobuf.Struct, google.protobuf.Value
+	// and google.protobuf.ListValue fields
+	Json string `protobuf:"bytes,24,opt,name=json,oneof"`
+}
+
+type FieldDefaults_Generate struct {
+	// Generated value for string and bytes fields
+	Generate *GenerateDefaults `protobuf:"bytes,25,opt,name=generate,oneof"`
+}
+
+type FieldDefaults_Env struct {
+	// Environment variable holding the value, either NAME or NAME:-fallback.
+	// The fallback is used if the variable is unset or empty.
+	Env string `protobuf:"bytes,26,opt,name=env,oneof"`
+}
+
+type FieldDefaults_Expr struct {
+	// CEL expression evaluated against the message, its fields being declared as variables,
+	// e.g. `scheme == "https" ? 443 : 80`. It can only be used on singular fields outside of oneofs.
+	Expr string `protobuf:"bytes,27,opt,name=expr,oneof"`
+}
+
+type FieldDefaults_FromField struct {
+	// Path of the field the value is copied from, e.g. `spec.name`, made of the names of the fields
+	// of the message and of its nested messages. The field must have the same type and is copied only if set.
+	// The fields are defaulted after the fields they are copied from. It can only be used on singular fields
+	// outside of oneofs.
+	FromField string `protobuf:"bytes,28,opt,name=from_field,json=fromField,oneof"`
+}
+
 func (*FieldDefaults_Float) isFieldDefaults_Type() {}
 
 func (*FieldDefaults_Double) isFieldDefaults_Type() {}
@@ -357,10 +558,132 @@ func (*FieldDefaults_Enum) isFieldDefaults_Type() {}
 
 func (*FieldDefaults_Message) isFieldDefaults_Type() {}
 
+func (*FieldDefaults_Repeated) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_Map) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_Any) isFieldDefaults_Type() {}
+
 func (*FieldDefaults_Duration) isFieldDefaults_Type() {}
 
 func (*FieldDefaults_Timestamp) isFieldDefaults_Type() {}
 
+func (*FieldDefaults_EnumName) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_Json) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_Generate) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_Env) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_Expr) isFieldDefaults_Type() {}
+
+func (*FieldDefaults_FromField) isFieldDefaults_Type() {}
+
+// When defines a default value applied if a condition on a sibling field holds.
+type When struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Field is the name of the sibling field the condition is evaluated on.
+	Field *string `protobuf:"bytes,1,opt,name=field" json:"field,omitempty"`
+	// Types that are assignable to Condition:
+	//
+	//	*When_Equals
+	//	*When_Has
+	Condition isWhen_Condition `protobuf_oneof:"condition"`
+	// Value is the default value applied if the condition holds.
+	Value *FieldDefaults `protobuf:"bytes,4,opt,name=value" json:"value,omitempty"`
+}
+
+func (x *When) Reset() {
+	*x = When{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_defaults_defaults_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *When) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*When) ProtoMessage() {}
+
+func (x *When) ProtoReflect() protoreflect.Message {
+	mi := &file_defaults_defaults_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use When.ProtoReflect.Descriptor instead.
+func (*When) Descriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *When) GetField() string {
+	if x != nil && x.Field != nil {
+		return *x.Field
+	}
+	return ""
+}
+
+func (m *When) GetCondition() isWhen_Condition {
+	if m != nil {
+		return m.Condition
+	}
+	return nil
+}
+
+func (x *When) GetEquals() string {
+	if x, ok := x.GetCondition().(*When_Equals); ok {
+		return x.Equals
+	}
+	return ""
+}
+
+func (x *When) GetHas() bool {
+	if x, ok := x.GetCondition().(*When_Has); ok {
+		return x.Has
+	}
+	return false
+}
+
+func (x *When) GetValue() *FieldDefaults {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type isWhen_Condition interface {
+	isWhen_Condition()
+}
+
+type When_Equals struct {
+	// Equals holds if the field value, as returned by its getter, equals the value,
+	// parsed as the environment variables values. It can only be used on singular
+	// scalar and enum fields, enums being matched by name or number.
+	Equals string `protobuf:"bytes,2,opt,name=equals,oneof"`
+}
+
+type When_Has struct {
+	// Has holds if the field presence matches: true if the field is set, false if it is not.
+	Has bool `protobuf:"varint,3,opt,name=has,oneof"`
+}
+
+func (*When_Equals) isWhen_Condition() {}
+
+func (*When_Has) isWhen_Condition() {}
+
 // MessageDefaults define the default behaviour for this field.
 type MessageDefaults struct {
 	state         protoimpl.MessageState
@@ -371,12 +694,20 @@ type MessageDefaults struct {
 	Initialize *bool `protobuf:"varint,1,opt,name=initialize" json:"initialize,omitempty"`
 	// Defaults specifies that the messages' defaults should be applied
 	Defaults *bool `protobuf:"varint,2,opt,name=defaults" json:"defaults,omitempty"`
+	// Literal specifies the message value merged into the unset fields
+	// of the message. The message is initialized if needed.
+	//
+	// Types that are assignable to Literal:
+	//
+	//	*MessageDefaults_Value
+	//	*MessageDefaults_Json
+	Literal isMessageDefaults_Literal `protobuf_oneof:"literal"`
 }
 
 func (x *MessageDefaults) Reset() {
 	*x = MessageDefaults{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_defaults_defaults_proto_msgTypes[1]
+		mi := &file_defaults_defaults_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -389,7 +720,7 @@ func (x *MessageDefaults) String() string {
 func (*MessageDefaults) ProtoMessage() {}
 
 func (x *MessageDefaults) ProtoReflect() protoreflect.Message {
-	mi := &file_defaults_defaults_proto_msgTypes[1]
+	mi := &file_defaults_defaults_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -402,7 +733,7 @@ func (x *MessageDefaults) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageDefaults.ProtoReflect.Descriptor instead.
 func (*MessageDefaults) Descriptor() ([]byte, []int) {
-	return file_defaults_defaults_proto_rawDescGZIP(), []int{1}
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *MessageDefaults) GetInitialize() bool {
@@ -419,6 +750,378 @@ func (x *MessageDefaults) GetDefaults() bool {
 	return false
 }
 
+func (m *MessageDefaults) GetLiteral() isMessageDefaults_Literal {
+	if m != nil {
+		return m.Literal
+	}
+	return nil
+}
+
+func (x *MessageDefaults) GetValue() string {
+	if x, ok := x.GetLiteral().(*MessageDefaults_Value); ok {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *MessageDefaults) GetJson() string {
+	if x, ok := x.GetLiteral().(*MessageDefaults_Json); ok {
+		return x.Json
+	}
+	return ""
+}
+
+type isMessageDefaults_Literal interface {
+	isMessageDefaults_Literal()
+}
+
+type MessageDefaults_Value struct {
+	// Value is the message value in protobuf text format
+	Value string `protobuf:"bytes,3,opt,name=value,oneof"`
+}
+
+type MessageDefaults_Json struct {
+	// Json is the message value in protobuf JSON format
+	Json string `protobuf:"bytes,4,opt,name=json,oneof"`
+}
+
+func (*MessageDefaults_Value) isMessageDefaults_Literal() {}
+
+func (*MessageDefaults_Json) isMessageDefaults_Literal() {}
+
+// RepeatedDefaults define the default items of a repeated field.
+type RepeatedDefaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Items specifies the values the list is set to when it is empty.
+	// Each item must match the type of the list's elements.
+	Items []*FieldDefaults `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+}
+
+func (x *RepeatedDefaults) Reset() {
+	*x = RepeatedDefaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_defaults_defaults_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepeatedDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepeatedDefaults) ProtoMessage() {}
+
+func (x *RepeatedDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_defaults_defaults_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepeatedDefaults.ProtoReflect.Descriptor instead.
+func (*RepeatedDefaults) Descriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepeatedDefaults) GetItems() []*FieldDefaults {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// MapDefaults define the default entries of a map field.
+type MapDefaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Entries specifies the entries the map is set to when it is empty.
+	Entries []*MapEntry `protobuf:"bytes,1,rep,name=entries" json:"entries,omitempty"`
+	// Merge specifies that the missing entries should also be added
+	// to a non empty map.
+	Merge *bool `protobuf:"varint,2,opt,name=merge" json:"merge,omitempty"`
+	// Values specifies the message defaults applied to every value of the map.
+	// It can only be used for maps with message values.
+	Values *MessageDefaults `protobuf:"bytes,3,opt,name=values" json:"values,omitempty"`
+}
+
+func (x *MapDefaults) Reset() {
+	*x = MapDefaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_defaults_defaults_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapDefaults) ProtoMessage() {}
+
+func (x *MapDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_defaults_defaults_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapDefaults.ProtoReflect.Descriptor instead.
+func (*MapDefaults) Descriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MapDefaults) GetEntries() []*MapEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *MapDefaults) GetMerge() bool {
+	if x != nil && x.Merge != nil {
+		return *x.Merge
+	}
+	return false
+}
+
+func (x *MapDefaults) GetValues() *MessageDefaults {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// MapEntry define a default map entry.
+type MapEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Key must match the type of the map's keys.
+	Key *FieldDefaults `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// Value must match the type of the map's values.
+	Value *FieldDefaults `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+}
+
+func (x *MapEntry) Reset() {
+	*x = MapEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_defaults_defaults_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapEntry) ProtoMessage() {}
+
+func (x *MapEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_defaults_defaults_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapEntry.ProtoReflect.Descriptor instead.
+func (*MapEntry) Descriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MapEntry) GetKey() *FieldDefaults {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MapEntry) GetValue() *FieldDefaults {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// AnyDefaults define the default value of a google.protobuf.Any field.
+type AnyDefaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TypeUrl is the type url of the packed message,
+	// e.g. type.googleapis.com/google.protobuf.Duration.
+	TypeUrl *string `protobuf:"bytes,1,opt,name=type_url,json=typeUrl" json:"type_url,omitempty"`
+	// Value is the packed message value. If none is set, the packed message is empty.
+	//
+	// Types that are assignable to Value:
+	//
+	//	*AnyDefaults_Text
+	//	*AnyDefaults_Json
+	Value isAnyDefaults_Value `protobuf_oneof:"value"`
+}
+
+func (x *AnyDefaults) Reset() {
+	*x = AnyDefaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_defaults_defaults_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnyDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyDefaults) ProtoMessage() {}
+
+func (x *AnyDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_defaults_defaults_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyDefaults.ProtoReflect.Descriptor instead.
+func (*AnyDefaults) Descriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AnyDefaults) GetTypeUrl() string {
+	if x != nil && x.TypeUrl != nil {
+		return *x.TypeUrl
+	}
+	return ""
+}
+
+func (m *AnyDefaults) GetValue() isAnyDefaults_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *AnyDefaults) GetText() string {
+	if x, ok := x.GetValue().(*AnyDefaults_Text); ok {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AnyDefaults) GetJson() string {
+	if x, ok := x.GetValue().(*AnyDefaults_Json); ok {
+		return x.Json
+	}
+	return ""
+}
+
+type isAnyDefaults_Value interface {
+	isAnyDefaults_Value()
+}
+
+type AnyDefaults_Text struct {
+	// Text is the packed message value in protobuf text format.
+	Text string `protobuf:"bytes,2,opt,name=text,oneof"`
+}
+
+type AnyDefaults_Json struct {
+	// Json is the packed message value in protobuf JSON format.
+	Json string `protobuf:"bytes,3,opt,name=json,oneof"`
+}
+
+func (*AnyDefaults_Text) isAnyDefaults_Value() {}
+
+func (*AnyDefaults_Json) isAnyDefaults_Value() {}
+
+// GenerateDefaults define a value generated each time the defaults are applied.
+// String fields are set to the textual representation of the value, bytes fields
+// to its binary representation, e.g. the 16 bytes of an UUID.
+type GenerateDefaults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type is the generator used.
+	Type *Generator `protobuf:"varint,1,opt,name=type,enum=defaults.Generator" json:"type,omitempty"`
+	// Length is the number of random bytes generated by RANDOM_HEX, defaults to 16.
+	Length *uint32 `protobuf:"varint,2,opt,name=length" json:"length,omitempty"`
+}
+
+func (x *GenerateDefaults) Reset() {
+	*x = GenerateDefaults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_defaults_defaults_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateDefaults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDefaults) ProtoMessage() {}
+
+func (x *GenerateDefaults) ProtoReflect() protoreflect.Message {
+	mi := &file_defaults_defaults_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDefaults.ProtoReflect.Descriptor instead.
+func (*GenerateDefaults) Descriptor() ([]byte, []int) {
+	return file_defaults_defaults_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GenerateDefaults) GetType() Generator {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return Generator_GENERATOR_UNSPECIFIED
+}
+
+func (x *GenerateDefaults) GetLength() uint32 {
+	if x != nil && x.Length != nil {
+		return *x.Length
+	}
+	return 0
+}
+
 var file_defaults_defaults_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
@@ -444,6 +1147,22 @@ var file_defaults_defaults_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "varint,1173,opt,name=unexported",
 		Filename:      "defaults/defaults.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1174,
+		Name:          "defaults.constructor",
+		Tag:           "varint,1174,opt,name=constructor",
+		Filename:      "defaults/defaults.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1171,
+		Name:          "defaults.proto2_defaults",
+		Tag:           "varint,1171,opt,name=proto2_defaults",
+		Filename:      "defaults/defaults.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
 		ExtensionType: (*string)(nil),
@@ -460,6 +1179,14 @@ var file_defaults_defaults_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1171,opt,name=value",
 		Filename:      "defaults/defaults.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: ([]*When)(nil),
+		Field:         1172,
+		Name:          "defaults.when",
+		Tag:           "bytes,1172,rep,name=when",
+		Filename:      "defaults/defaults.proto",
+	},
 }
 
 // Extension fields to descriptorpb.MessageOptions.
@@ -479,12 +1206,28 @@ var (
 	//
 	// optional bool unexported = 1173;
 	E_Unexported = &file_defaults_defaults_proto_extTypes[2]
+	// Constructor generates the New<Message>() and New<Message>With(func(*Message))
+	// constructors returning a message with its defaults applied. It overrides
+	// the constructors plugin parameter.
+	//
+	// optional bool constructor = 1174;
+	E_Constructor = &file_defaults_defaults_proto_extTypes[3]
+)
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// Proto2Defaults uses the native proto2 default values, declared with [default = ...],
+	// as defaults rules for the fields without (defaults.value). They are then set explicitly
+	// and survive serialization. It enables the proto2_defaults plugin parameter for the file.
+	//
+	// optional bool proto2_defaults = 1171;
+	E_Proto2Defaults = &file_defaults_defaults_proto_extTypes[4]
 )
 
 // Extension fields to descriptorpb.OneofOptions.
 var (
 	// optional string oneof = 1171;
-	E_Oneof = &file_defaults_defaults_proto_extTypes[3]
+	E_Oneof = &file_defaults_defaults_proto_extTypes[5]
 )
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -493,7 +1236,13 @@ var (
 	// none is set on a field.
 	//
 	// optional defaults.FieldDefaults value = 1171;
-	E_Value = &file_defaults_defaults_proto_extTypes[4]
+	E_Value = &file_defaults_defaults_proto_extTypes[6]
+	// When specifies conditional default values depending on the sibling fields. The conditions
+	// are evaluated in order and the value of the first one holding is applied instead of the
+	// (defaults.value) one. It cannot be used on oneof fields.
+	//
+	// repeated defaults.When when = 1172;
+	E_When = &file_defaults_defaults_proto_extTypes[7]
 )
 
 var File_defaults_defaults_proto protoreflect.FileDescriptor
@@ -503,7 +1252,7 @@ var file_defaults_defaults_proto_rawDesc = []byte{
 	0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75,
 	0x6c, 0x74, 0x73, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xae, 0x04, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x06, 0x0a, 0x0d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44,
 	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12,
 	0x18, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x48,
@@ -533,40 +1282,124 @@ var file_defaults_defaults_proto_rawDesc = []byte{
 	0x6e, 0x75, 0x6d, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x11,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
 	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x48,
-	0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x64, 0x75,
+	0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x70,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x12,
+	0x29, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x41, 0x6e, 0x79, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x1c, 0x0a, 0x08, 0x64, 0x75,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08,
 	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x4a, 0x04, 0x08, 0x12, 0x10, 0x15, 0x22, 0x4d, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x3c, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62,
-	0x6c, 0x65, 0x64, 0x3a, 0x3a, 0x0a, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1f,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x09, 0x65, 0x6e, 0x75, 0x6d,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x65,
+	0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x08, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x48, 0x00, 0x52, 0x08, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x04, 0x65,
+	0x78, 0x70, 0x72, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x65, 0x78, 0x70,
+	0x72, 0x12, 0x1f, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x1c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x04, 0x57,
+	0x68, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x18, 0x0a, 0x06, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x65, 0x71, 0x75,
+	0x61, 0x6c, 0x73, 0x12, 0x12, 0x0a, 0x03, 0x68, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x03, 0x68, 0x61, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x42, 0x09, 0x0a, 0x07, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x22, 0x41, 0x0a, 0x10,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x12, 0x2d, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x84, 0x01, 0x0a, 0x0b, 0x4d, 0x61, 0x70, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x2c, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x29, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x5d, 0x0a, 0x0b,
+	0x41, 0x6e, 0x79, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74,
+	0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x04,
+	0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73,
+	0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x53, 0x0a, 0x10, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x2a, 0x80, 0x01, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x45, 0x4e, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x55, 0x49,
+	0x44, 0x5f, 0x56, 0x34, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x55, 0x49, 0x44, 0x5f, 0x56,
+	0x37, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x55, 0x4c, 0x49, 0x44, 0x10, 0x03, 0x12, 0x07, 0x0a,
+	0x03, 0x58, 0x49, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54,
+	0x5f, 0x49, 0x44, 0x10, 0x05, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x5f,
+	0x48, 0x45, 0x58, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d,
+	0x45, 0x10, 0x07, 0x3a, 0x3c, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x3a, 0x3a, 0x0a, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x94, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x3a, 0x40, 0x0a,
+	0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x95, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x3a,
+	0x42, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x1f,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x94, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x3a,
-	0x40, 0x0a, 0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1f, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x95,
-	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x3a, 0x34, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x93, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e,
-	0x6b, 0x61, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
-	0x67, 0x65, 0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x73, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x96, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x6f, 0x72, 0x3a, 0x46, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x5f, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x32, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x3a, 0x34, 0x0a, 0x05, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x3a, 0x4d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x93, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x42, 0x0a, 0x04, 0x77, 0x68, 0x65, 0x6e, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x94, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x57, 0x68, 0x65, 0x6e, 0x52, 0x04,
+	0x77, 0x68, 0x65, 0x6e, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x3b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
 }
 
 var (
@@ -581,27 +1414,51 @@ func file_defaults_defaults_proto_rawDescGZIP() []byte {
 	return file_defaults_defaults_proto_rawDescData
 }
 
-var file_defaults_defaults_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_defaults_defaults_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_defaults_defaults_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_defaults_defaults_proto_goTypes = []interface{}{
-	(*FieldDefaults)(nil),               // 0: defaults.FieldDefaults
-	(*MessageDefaults)(nil),             // 1: defaults.MessageDefaults
-	(*descriptorpb.MessageOptions)(nil), // 2: google.protobuf.MessageOptions
-	(*descriptorpb.OneofOptions)(nil),   // 3: google.protobuf.OneofOptions
-	(*descriptorpb.FieldOptions)(nil),   // 4: google.protobuf.FieldOptions
+	(Generator)(0),                      // 0: defaults.Generator
+	(*FieldDefaults)(nil),               // 1: defaults.FieldDefaults
+	(*When)(nil),                        // 2: defaults.When
+	(*MessageDefaults)(nil),             // 3: defaults.MessageDefaults
+	(*RepeatedDefaults)(nil),            // 4: defaults.RepeatedDefaults
+	(*MapDefaults)(nil),                 // 5: defaults.MapDefaults
+	(*MapEntry)(nil),                    // 6: defaults.MapEntry
+	(*AnyDefaults)(nil),                 // 7: defaults.AnyDefaults
+	(*GenerateDefaults)(nil),            // 8: defaults.GenerateDefaults
+	(*descriptorpb.MessageOptions)(nil), // 9: google.protobuf.MessageOptions
+	(*descriptorpb.FileOptions)(nil),    // 10: google.protobuf.FileOptions
+	(*descriptorpb.OneofOptions)(nil),   // 11: google.protobuf.OneofOptions
+	(*descriptorpb.FieldOptions)(nil),   // 12: google.protobuf.FieldOptions
 }
 var file_defaults_defaults_proto_depIdxs = []int32{
-	1, // 0: defaults.FieldDefaults.message:type_name -> defaults.MessageDefaults
-	2, // 1: defaults.disabled:extendee -> google.protobuf.MessageOptions
-	2, // 2: defaults.ignored:extendee -> google.protobuf.MessageOptions
-	2, // 3: defaults.unexported:extendee -> google.protobuf.MessageOptions
-	3, // 4: defaults.oneof:extendee -> google.protobuf.OneofOptions
-	4, // 5: defaults.value:extendee -> google.protobuf.FieldOptions
-	0, // 6: defaults.value:type_name -> defaults.FieldDefaults
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	6, // [6:7] is the sub-list for extension type_name
-	1, // [1:6] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3,  // 0: defaults.FieldDefaults.message:type_name -> defaults.MessageDefaults
+	4,  // 1: defaults.FieldDefaults.repeated:type_name -> defaults.RepeatedDefaults
+	5,  // 2: defaults.FieldDefaults.map:type_name -> defaults.MapDefaults
+	7,  // 3: defaults.FieldDefaults.any:type_name -> defaults.AnyDefaults
+	8,  // 4: defaults.FieldDefaults.generate:type_name -> defaults.GenerateDefaults
+	1,  // 5: defaults.When.value:type_name -> defaults.FieldDefaults
+	1,  // 6: defaults.RepeatedDefaults.items:type_name -> defaults.FieldDefaults
+	6,  // 7: defaults.MapDefaults.entries:type_name -> defaults.MapEntry
+	3,  // 8: defaults.MapDefaults.values:type_name -> defaults.MessageDefaults
+	1,  // 9: defaults.MapEntry.key:type_name -> defaults.FieldDefaults
+	1,  // 10: defaults.MapEntry.value:type_name -> defaults.FieldDefaults
+	0,  // 11: defaults.GenerateDefaults.type:type_name -> defaults.Generator
+	9,  // 12: defaults.disabled:extendee -> google.protobuf.MessageOptions
+	9,  // 13: defaults.ignored:extendee -> google.protobuf.MessageOptions
+	9,  // 14: defaults.unexported:extendee -> google.protobuf.MessageOptions
+	9,  // 15: defaults.constructor:extendee -> google.protobuf.MessageOptions
+	10, // 16: defaults.proto2_defaults:extendee -> google.protobuf.FileOptions
+	11, // 17: defaults.oneof:extendee -> google.protobuf.OneofOptions
+	12, // 18: defaults.value:extendee -> google.protobuf.FieldOptions
+	12, // 19: defaults.when:extendee -> google.protobuf.FieldOptions
+	1,  // 20: defaults.value:type_name -> defaults.FieldDefaults
+	2,  // 21: defaults.when:type_name -> defaults.When
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	20, // [20:22] is the sub-list for extension type_name
+	12, // [12:20] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_defaults_defaults_proto_init() }
@@ -623,6 +1480,18 @@ func file_defaults_defaults_proto_init() {
 			}
 		}
 		file_defaults_defaults_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*When); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_defaults_defaults_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageDefaults); i {
 			case 0:
 				return &v.state
@@ -634,6 +1503,66 @@ func file_defaults_defaults_proto_init() {
 				return nil
 			}
 		}
+		file_defaults_defaults_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedDefaults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_defaults_defaults_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapDefaults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_defaults_defaults_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_defaults_defaults_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyDefaults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_defaults_defaults_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateDefaults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_defaults_defaults_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*FieldDefaults_Float)(nil),
@@ -653,21 +1582,43 @@ func file_defaults_defaults_proto_init() {
 		(*FieldDefaults_Bytes)(nil),
 		(*FieldDefaults_Enum)(nil),
 		(*FieldDefaults_Message)(nil),
+		(*FieldDefaults_Repeated)(nil),
+		(*FieldDefaults_Map)(nil),
+		(*FieldDefaults_Any)(nil),
 		(*FieldDefaults_Duration)(nil),
 		(*FieldDefaults_Timestamp)(nil),
+		(*FieldDefaults_EnumName)(nil),
+		(*FieldDefaults_Json)(nil),
+		(*FieldDefaults_Generate)(nil),
+		(*FieldDefaults_Env)(nil),
+		(*FieldDefaults_Expr)(nil),
+		(*FieldDefaults_FromField)(nil),
+	}
+	file_defaults_defaults_proto_msgTypes[1].OneofWrappers = []interface{}{
+		(*When_Equals)(nil),
+		(*When_Has)(nil),
+	}
+	file_defaults_defaults_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*MessageDefaults_Value)(nil),
+		(*MessageDefaults_Json)(nil),
+	}
+	file_defaults_defaults_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*AnyDefaults_Text)(nil),
+		(*AnyDefaults_Json)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_defaults_defaults_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
-			NumExtensions: 5,
+			NumEnums:      1,
+			NumMessages:   8,
+			NumExtensions: 8,
 			NumServices:   0,
 		},
 		GoTypes:           file_defaults_defaults_proto_goTypes,
 		DependencyIndexes: file_defaults_defaults_proto_depIdxs,
+		EnumInfos:         file_defaults_defaults_proto_enumTypes,
 		MessageInfos:      file_defaults_defaults_proto_msgTypes,
 		ExtensionInfos:    file_defaults_defaults_proto_extTypes,
 	}.Build()
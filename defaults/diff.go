@@ -0,0 +1,133 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// FieldDiff describes a field having a default value.
+type FieldDiff struct {
+	// Path is the path of the field, as reported by ApplyReport.
+	Path FieldPath
+	// Default is the value set by the defaults to the field when it is unset,
+	// the conditional defaults being resolved against the message.
+	// It is not valid if the defaults do not set it or are not constant, e.g. an environment
+	// variable or a oneof member other than the default one.
+	Default reflect.Value
+	// Value is the current value of the field, not valid if the field is unset.
+	Value reflect.Value
+	// Differs is set if the field is set to another value than its default.
+	Differs bool
+}
+
+// Diff returns the fields of m having a default value, recursing into the messages set whose
+// defaults are applied. The messages and the map values are only described by their fields,
+// unless they are set by a literal or initialized.
+//
+// The defaults which are not constant, i.e. environment variables, generated values and `now`
+// or relative timestamps, are not computed and never differ, as they cannot be compared.
+func Diff(m proto.Message) []FieldDiff {
+	if m == nil {
+		return nil
+	}
+	var diffs []FieldDiff
+	s := scope{options: &options{now: time.Now, resolver: protoregistry.GlobalTypes}, depth: 1}
+	s.diff(m.ProtoReflect(), &diffs)
+	return diffs
+}
+
+// IsDefault reports whether none of the fields of m having a default value is set to another value.
+func IsDefault(m proto.Message) bool {
+	for _, v := range Diff(m) {
+		if v.Differs {
+			return false
+		}
+	}
+	return true
+}
+
+func (s scope) diff(mref reflect.Message, diffs *[]FieldDiff) {
+	p := planOf(mref.Descriptor(), s.proto2)
+	if p.skip {
+		return
+	}
+	for _, fp := range p.fields {
+		if fp.err != nil {
+			continue
+		}
+		if fp = fp.resolve(mref); fp == nil {
+			continue
+		}
+		f := fp.fd
+		fs, _ := s.field(fp.name)
+		if hasValue(fp) {
+			d := FieldDiff{Path: FieldPath(fs.path)}
+			d.Default, _ = s.defaultValue(mref, fp)
+			if mref.Has(f) {
+				d.Value = mref.Get(f)
+				d.Differs = !fp.oneof || IsConstant(fp.rules) && (!d.Default.IsValid() || !equal(f, d.Value, d.Default))
+			}
+			*diffs = append(*diffs, d)
+		}
+		if !mref.Has(f) {
+			continue
+		}
+		md := fp.rules.GetMessage()
+		if f.IsMap() && fp.rules.GetMap() != nil {
+			md = fp.rules.GetMap().GetValues()
+		}
+		switch {
+		case f.IsList():
+			if md.GetDefaults() && md.GetLiteral() == nil {
+				l := mref.Get(f).List()
+				for i := 0; i < l.Len(); i++ {
+					if v := l.Get(i).Message(); v.IsValid() {
+						fs.key(i).diff(v, diffs)
+					}
+				}
+			}
+		case f.IsMap():
+			if md.GetDefaults() && md.GetLiteral() == nil {
+				mref.Get(f).Map().Range(func(k reflect.MapKey, v reflect.Value) bool {
+					if v.Message().IsValid() {
+						fs.key(k.Interface()).diff(v.Message(), diffs)
+					}
+					return true
+				})
+			}
+		case md != nil && f.Kind() == reflect.MessageKind:
+			if md.GetLiteral() == nil && messageDefaults(md) {
+				fs.diff(mref.Get(f).Message(), diffs)
+			}
+		}
+	}
+}
+
+// hasValue reports whether the rules of fp describe a value for the field itself,
+// and not only the defaults of the messages it holds.
+func hasValue(fp *fieldPlan) bool {
+	switch r := fp.rules.GetType().(type) {
+	case *FieldDefaults_Message:
+		return !fp.fd.IsList() && !fp.fd.IsMap() && (r.Message.GetLiteral() != nil || r.Message.GetInitialize())
+	case *FieldDefaults_Map:
+		return len(r.Map.GetEntries()) != 0
+	}
+	return true
+}
@@ -0,0 +1,191 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// ErrorHandler is called with the errors which cannot be returned while applying
+// the defaults, e.g. an invalid environment variable value. It logs the errors by default,
+// and can be replaced to handle or ignore them, DefaultE and ApplyE returning them instead.
+var ErrorHandler = func(err error) {
+	log.Printf("defaults: %v", err)
+}
+
+// EnvValue returns the value of the field fd read from the environment variable described by spec,
+// either NAME or NAME:-fallback. The fallback is used if the variable is unset or empty.
+// It returns false if neither the variable nor the fallback is set.
+func EnvValue(fd reflect.FieldDescriptor, spec string) (reflect.Value, bool, error) {
+	return envValue(fd, spec, time.Now)
+}
+
+// envValue is like EnvValue, timestamps being evaluated at now().
+func envValue(fd reflect.FieldDescriptor, spec string, now func() time.Time) (reflect.Value, bool, error) {
+	name, fallback, ok := splitEnv(spec)
+	s := os.Getenv(name)
+	if s == "" {
+		if !ok {
+			return reflect.Value{}, false, nil
+		}
+		s = fallback
+	}
+	v, err := parseValue(fd, s, now)
+	if err != nil {
+		return reflect.Value{}, false, fmt.Errorf("%s: %w", name, err)
+	}
+	return v, true, nil
+}
+
+func splitEnv(spec string) (name, fallback string, ok bool) {
+	parts := strings.SplitN(spec, ":-", 2)
+	if len(parts) == 1 {
+		return strings.TrimSpace(parts[0]), "", false
+	}
+	return strings.TrimSpace(parts[0]), parts[1], true
+}
+
+// ParseValue parses s as a value of the field fd. Enums are parsed by name or number,
+// Durations using the Prometheus format and Timestamps using the RFC formats,
+// `now` or a relative timestamp. Wrappers are parsed as their wrapped value.
+func ParseValue(fd reflect.FieldDescriptor, s string) (reflect.Value, error) {
+	return parseValue(fd, s, time.Now)
+}
+
+func parseValue(fd reflect.FieldDescriptor, s string, now func() time.Time) (reflect.Value, error) {
+	s = strings.TrimSpace(s)
+	switch fd.Kind() {
+	case reflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		return reflect.ValueOf(v), err
+	case reflect.EnumKind:
+		if v := enumValue(fd.Enum(), s); v != nil {
+			return reflect.ValueOf(v.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil || fd.Enum().Values().ByNumber(reflect.EnumNumber(n)) == nil {
+			return reflect.Value{}, fmt.Errorf("unknown %s value: %q", fd.Enum().FullName(), s)
+		}
+		return reflect.ValueOf(reflect.EnumNumber(n)), nil
+	case reflect.Int32Kind, reflect.Sint32Kind, reflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		return reflect.ValueOf(int32(v)), err
+	case reflect.Int64Kind, reflect.Sint64Kind, reflect.Sfixed64Kind:
+		v, err := strconv.ParseInt(s, 10, 64)
+		return reflect.ValueOf(v), err
+	case reflect.Uint32Kind, reflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		return reflect.ValueOf(uint32(v)), err
+	case reflect.Uint64Kind, reflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		return reflect.ValueOf(v), err
+	case reflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		return reflect.ValueOf(float32(v)), err
+	case reflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		return reflect.ValueOf(v), err
+	case reflect.StringKind:
+		return reflect.ValueOf(s), nil
+	case reflect.BytesKind:
+		return reflect.ValueOf([]byte(s)), nil
+	case reflect.MessageKind:
+		return parseMessage(fd.Message(), s, now)
+	}
+	return reflect.Value{}, fmt.Errorf("unsupported field kind: %v", fd.Kind())
+}
+
+func parseMessage(md reflect.MessageDescriptor, s string, now func() time.Time) (reflect.Value, error) {
+	var v interface{ ProtoReflect() reflect.Message }
+	switch md.FullName() {
+	case "google.protobuf.Duration":
+		d, err := model.ParseDuration(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = durationpb.New(time.Duration(d))
+	case "google.protobuf.Timestamp":
+		var t time.Time
+		var err error
+		if strings.ToLower(s) == "now" {
+			t = now()
+		} else if t, err = parseTime(s); err != nil {
+			if t, err = RelativeTime(s, now()); err != nil {
+				return reflect.Value{}, err
+			}
+		}
+		v = timestamppb.New(t)
+	case "google.protobuf.DoubleValue":
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.Double(f)
+	case "google.protobuf.FloatValue":
+		f, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.Float(float32(f))
+	case "google.protobuf.Int64Value":
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.Int64(n)
+	case "google.protobuf.UInt64Value":
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.UInt64(n)
+	case "google.protobuf.Int32Value":
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.Int32(int32(n))
+	case "google.protobuf.UInt32Value":
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.UInt32(uint32(n))
+	case "google.protobuf.BoolValue":
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		v = wrapperspb.Bool(b)
+	case "google.protobuf.StringValue":
+		v = wrapperspb.String(s)
+	case "google.protobuf.BytesValue":
+		v = wrapperspb.Bytes([]byte(s))
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported message type: %s", md.FullName())
+	}
+	return reflect.ValueOf(v.ProtoReflect()), nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"strings"
+)
+
+// FieldError is an error encountered while applying the defaults of a field.
+type FieldError struct {
+	// Path is the path of the field from the message the defaults are applied to,
+	// e.g. policy.items[0].name or labels["key"].
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Errors aggregates the errors encountered while applying the defaults.
+type Errors []*FieldError
+
+func (e Errors) Error() string {
+	var parts []string
+	for _, v := range e {
+		parts = append(parts, v.Error())
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Err returns e as an error, or nil if e is empty.
+func (e Errors) Err() error {
+	if len(e) == 0 {
+		return nil
+	}
+	return e
+}
+
+// Add adds err for the field path. If err is an Errors, its errors are added
+// with their path prefixed by path. Nil and empty errors are ignored.
+func (e *Errors) Add(path string, err error) {
+	switch v := err.(type) {
+	case nil:
+	case Errors:
+		for _, v := range v {
+			*e = append(*e, &FieldError{Path: joinPath(path, v.Path), Err: v.Err})
+		}
+	case *FieldError:
+		*e = append(*e, &FieldError{Path: joinPath(path, v.Path), Err: v.Err})
+	default:
+		*e = append(*e, &FieldError{Path: path, Err: err})
+	}
+}
+
+// AddKey is like Add for the element of the repeated or map field path
+// at the given index or key.
+func (e *Errors) AddKey(path string, key interface{}, err error) {
+	e.Add(string(KeyPath(path, key)), err)
+}
+
+func joinPath(prefix, path string) string {
+	switch {
+	case prefix == "":
+		return path
+	case path == "":
+		return prefix
+	case strings.HasPrefix(path, "["):
+		return prefix + path
+	}
+	return prefix + "." + path
+}
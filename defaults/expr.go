@@ -0,0 +1,55 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ExprEvaluator evaluates the expressions of the expr rules.
+type ExprEvaluator interface {
+	// Value evaluates the expression expr against the message mref and returns its result
+	// as a value of the field fd of mref, or false if the result is null.
+	Value(mref reflect.Message, fd reflect.FieldDescriptor, expr string) (reflect.Value, bool, error)
+	// Deps returns the names of the fields of the message of fd referenced by the expression expr,
+	// or nil if it is invalid.
+	Deps(fd reflect.FieldDescriptor, expr string) []string
+}
+
+// exprEvaluator is the evaluator registered by RegisterExpr.
+var exprEvaluator ExprEvaluator
+
+// RegisterExpr registers the evaluator of the expr rules. It is called when importing the
+// go.linka.cloud/protoc-gen-defaults/defaults/expr package, which the generated code of
+// the messages using expressions imports, so that the core package does not depend on CEL.
+func RegisterExpr(e ExprEvaluator) {
+	exprEvaluator = e
+}
+
+func exprValue(mref reflect.Message, fd reflect.FieldDescriptor, expr string) (reflect.Value, bool, error) {
+	if exprEvaluator == nil {
+		return reflect.Value{}, false, fmt.Errorf("%s: no expression evaluator registered, import go.linka.cloud/protoc-gen-defaults/defaults/expr", expr)
+	}
+	return exprEvaluator.Value(mref, fd, expr)
+}
+
+func exprDeps(fd reflect.FieldDescriptor, expr string) []string {
+	if exprEvaluator == nil {
+		return nil
+	}
+	return exprEvaluator.Deps(fd, expr)
+}
@@ -0,0 +1,276 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expr evaluates the CEL expressions of the `(defaults.value).expr` rules.
+//
+// It is imported by the generated code of the messages using expressions, and registers itself
+// to the defaults package, so that defaults.Apply evaluates them too. Importing it is otherwise
+// required to apply the expressions with the reflection walker.
+package expr
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+func init() {
+	defaults.RegisterExpr(evaluator{})
+}
+
+// evaluator is the defaults.ExprEvaluator compiling the expressions with CEL.
+type evaluator struct{}
+
+func (evaluator) Value(mref reflect.Message, fd reflect.FieldDescriptor, expr string) (reflect.Value, bool, error) {
+	return value(mref, fd, expr)
+}
+
+func (evaluator) Deps(fd reflect.FieldDescriptor, expr string) []string {
+	return deps(fd, expr)
+}
+
+// programs caches the compiled expressions by programKey.
+var programs sync.Map
+
+// programKey identifies an expression compiled for a message type.
+type programKey struct {
+	md   reflect.MessageDescriptor
+	expr string
+}
+
+type program struct {
+	prg cel.Program
+	err error
+}
+
+// Value evaluates the CEL expression expr against the message m and returns its result
+// as a value of the field fd of m, or false if the result is null.
+// The expressions are compiled once per message type.
+func Value(m proto.Message, fd reflect.FieldDescriptor, expr string) (reflect.Value, bool, error) {
+	return value(m.ProtoReflect(), fd, expr)
+}
+
+// Check compiles the CEL expression expr against the message holding the field fd
+// and checks that its result can be set to the field.
+func Check(fd reflect.FieldDescriptor, expr string) error {
+	_, err := compile(fd, expr)
+	return err
+}
+
+func value(mref reflect.Message, fd reflect.FieldDescriptor, expr string) (reflect.Value, bool, error) {
+	k := programKey{md: mref.Descriptor(), expr: expr}
+	p, ok := programs.Load(k)
+	if !ok {
+		prg, err := compile(fd, expr)
+		p, _ = programs.LoadOrStore(k, &program{prg: prg, err: err})
+	}
+	prg, err := p.(*program).prg, p.(*program).err
+	if err != nil {
+		return reflect.Value{}, false, err
+	}
+	vars, err := cel.ContextProtoVars(mref.Interface())
+	if err != nil {
+		return reflect.Value{}, false, err
+	}
+	out, _, err := prg.Eval(vars)
+	if err != nil {
+		return reflect.Value{}, false, fmt.Errorf("%s: %w", expr, err)
+	}
+	if out == types.NullValue {
+		return reflect.Value{}, false, nil
+	}
+	v, err := result(mref.NewField(fd), fd, out)
+	if err != nil {
+		return reflect.Value{}, false, fmt.Errorf("%s: %w", expr, err)
+	}
+	return v, true, nil
+}
+
+// compile returns the program of the expression expr, declaring the fields of the message
+// holding the field fd as variables.
+func compile(fd reflect.FieldDescriptor, expr string) (cel.Program, error) {
+	env, ast, err := check(fd, expr)
+	if err != nil {
+		return nil, err
+	}
+	return env.Program(ast)
+}
+
+// check type checks the expression expr against the message holding the field fd.
+func check(fd reflect.FieldDescriptor, expr string) (*cel.Env, *cel.Ast, error) {
+	if fd.IsList() || fd.IsMap() || fd.ContainingOneof() != nil && !fd.ContainingOneof().IsSynthetic() {
+		return nil, nil, fmt.Errorf("expr can only be used on singular fields outside of oneofs")
+	}
+	env, err := cel.NewEnv(cel.DeclareContextProto(fd.ContainingMessage()))
+	if err != nil {
+		return nil, nil, err
+	}
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, nil, fmt.Errorf("%s: %w", expr, iss.Err())
+	}
+	if want := celType(fd); !assignable(want, ast.OutputType()) {
+		return nil, nil, fmt.Errorf("%s: expected %s result, got %s", expr, want, ast.OutputType())
+	}
+	return env, ast, nil
+}
+
+// deps returns the names of the variables referenced by the expression expr, or nil if it is invalid.
+func deps(fd reflect.FieldDescriptor, expr string) []string {
+	_, ast, err := check(fd, expr)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, r := range ast.NativeRep().ReferenceMap() {
+		if len(r.OverloadIDs) == 0 && r.Value == nil {
+			names = append(names, r.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// celType returns the CEL type of the values of the field fd.
+func celType(fd reflect.FieldDescriptor) *cel.Type {
+	switch fd.Kind() {
+	case reflect.BoolKind:
+		return cel.BoolType
+	case reflect.EnumKind, reflect.Int32Kind, reflect.Sint32Kind, reflect.Sfixed32Kind,
+		reflect.Int64Kind, reflect.Sint64Kind, reflect.Sfixed64Kind:
+		return cel.IntType
+	case reflect.Uint32Kind, reflect.Fixed32Kind, reflect.Uint64Kind, reflect.Fixed64Kind:
+		return cel.UintType
+	case reflect.FloatKind, reflect.DoubleKind:
+		return cel.DoubleType
+	case reflect.StringKind:
+		return cel.StringType
+	case reflect.BytesKind:
+		return cel.BytesType
+	}
+	switch md := fd.Message(); md.FullName() {
+	case "google.protobuf.Duration":
+		return cel.DurationType
+	case "google.protobuf.Timestamp":
+		return cel.TimestampType
+	case "google.protobuf.DoubleValue", "google.protobuf.FloatValue", "google.protobuf.Int64Value",
+		"google.protobuf.UInt64Value", "google.protobuf.Int32Value", "google.protobuf.UInt32Value",
+		"google.protobuf.BoolValue", "google.protobuf.StringValue", "google.protobuf.BytesValue":
+		return celType(md.Fields().ByName("value"))
+	default:
+		return cel.ObjectType(string(md.FullName()))
+	}
+}
+
+// assignable reports whether a result of type got can be set to a field of type want.
+func assignable(want, got *cel.Type) bool {
+	return got.IsExactType(cel.DynType) || want.IsAssignableType(got) || cel.NullableType(want).IsAssignableType(got)
+}
+
+// result converts the result v of an expression to a value of the field fd,
+// n being a new value of the field if it is a message.
+func result(n reflect.Value, fd reflect.FieldDescriptor, v ref.Val) (reflect.Value, error) {
+	i := v.Value()
+	switch fd.Kind() {
+	case reflect.BoolKind:
+		if b, ok := i.(bool); ok {
+			return reflect.ValueOfBool(b), nil
+		}
+	case reflect.StringKind:
+		if s, ok := i.(string); ok {
+			return reflect.ValueOfString(s), nil
+		}
+	case reflect.BytesKind:
+		if b, ok := i.([]byte); ok {
+			return reflect.ValueOfBytes(b), nil
+		}
+	case reflect.FloatKind, reflect.DoubleKind:
+		f, ok := i.(float64)
+		if !ok {
+			break
+		}
+		if fd.Kind() == reflect.FloatKind {
+			return reflect.ValueOfFloat32(float32(f)), nil
+		}
+		return reflect.ValueOfFloat64(f), nil
+	case reflect.Int64Kind, reflect.Sint64Kind, reflect.Sfixed64Kind:
+		if n, ok := i.(int64); ok {
+			return reflect.ValueOfInt64(n), nil
+		}
+	case reflect.Uint64Kind, reflect.Fixed64Kind:
+		if n, ok := i.(uint64); ok {
+			return reflect.ValueOfUint64(n), nil
+		}
+	case reflect.EnumKind, reflect.Int32Kind, reflect.Sint32Kind, reflect.Sfixed32Kind:
+		n, ok := i.(int64)
+		if !ok {
+			break
+		}
+		if n < math.MinInt32 || n > math.MaxInt32 {
+			return reflect.Value{}, fmt.Errorf("%d overflows %s", n, fd.Kind())
+		}
+		if fd.Kind() == reflect.EnumKind {
+			return reflect.ValueOfEnum(reflect.EnumNumber(n)), nil
+		}
+		return reflect.ValueOfInt32(int32(n)), nil
+	case reflect.Uint32Kind, reflect.Fixed32Kind:
+		n, ok := i.(uint64)
+		if !ok {
+			break
+		}
+		if n > math.MaxUint32 {
+			return reflect.Value{}, fmt.Errorf("%d overflows %s", n, fd.Kind())
+		}
+		return reflect.ValueOfUint32(uint32(n)), nil
+	case reflect.MessageKind:
+		switch i := i.(type) {
+		case time.Duration:
+			return messageResult(n, durationpb.New(i))
+		case time.Time:
+			return messageResult(n, timestamppb.New(i))
+		case proto.Message:
+			return messageResult(n, i)
+		}
+		if f := fd.Message().Fields().ByName("value"); f != nil && celType(fd).IsExactType(celType(f)) {
+			v, err := result(reflect.Value{}, f, v)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			n.Message().Set(f, v)
+			return n, nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("cannot use %s result as %s value", v.Type().TypeName(), defaults.FieldType(fd))
+}
+
+// messageResult returns the message v as the new field value n, if they have the same type.
+func messageResult(n reflect.Value, v proto.Message) (reflect.Value, error) {
+	if v.ProtoReflect().Descriptor().FullName() != n.Message().Descriptor().FullName() {
+		return reflect.Value{}, fmt.Errorf("cannot use %s result as %s value", v.ProtoReflect().Descriptor().FullName(), n.Message().Descriptor().FullName())
+	}
+	proto.Merge(n.Message().Interface(), v)
+	return n, nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CheckFromField resolves the path against the message holding the field fd
+// and checks that the field it leads to has the same type as fd.
+func CheckFromField(fd reflect.FieldDescriptor, path string) error {
+	_, err := fromFieldPath(fd, path)
+	return err
+}
+
+// fromFieldPath returns the fields leading from the message holding the field fd to the source of its value.
+func fromFieldPath(fd reflect.FieldDescriptor, path string) ([]reflect.FieldDescriptor, error) {
+	if fd.IsList() || fd.IsMap() || fd.ContainingOneof() != nil && !fd.ContainingOneof().IsSynthetic() {
+		return nil, fmt.Errorf("from_field can only be used on singular fields outside of oneofs")
+	}
+	var fds []reflect.FieldDescriptor
+	md := fd.ContainingMessage()
+	for _, name := range strings.Split(strings.TrimSpace(path), ".") {
+		if md == nil {
+			return nil, fmt.Errorf("from_field %s: field %s is not a message", path, fds[len(fds)-1].FullName())
+		}
+		f := md.Fields().ByName(reflect.Name(name))
+		if f == nil {
+			return nil, fmt.Errorf("from_field %s: %s has no field %s", path, md.FullName(), name)
+		}
+		if f.IsList() || f.IsMap() {
+			return nil, fmt.Errorf("from_field %s: field %s is not singular", path, f.FullName())
+		}
+		fds = append(fds, f)
+		md = f.Message()
+	}
+	if fds[0].Number() == fd.Number() {
+		return nil, fmt.Errorf("from_field %s: field %s cannot be copied from itself", path, fd.FullName())
+	}
+	if src := fds[len(fds)-1]; !sameFieldType(fd, src) {
+		return nil, fmt.Errorf("from_field %s: cannot copy %s value to %s field", path, FieldType(src), FieldType(fd))
+	}
+	return fds, nil
+}
+
+// sameFieldType reports whether the values of the fields a and b have the same type.
+func sameFieldType(a, b reflect.FieldDescriptor) bool {
+	switch {
+	case a.Kind() != b.Kind():
+		return false
+	case a.Enum() != nil:
+		return a.Enum().FullName() == b.Enum().FullName()
+	case a.Message() != nil:
+		return a.Message().FullName() == b.Message().FullName()
+	}
+	return true
+}
+
+// fromFieldValue returns a copy of the value of the field at path in mref, or false if it is not set.
+func fromFieldValue(mref reflect.Message, fd reflect.FieldDescriptor, path string) (reflect.Value, bool, error) {
+	fds, err := fromFieldPath(fd, path)
+	if err != nil {
+		return reflect.Value{}, false, err
+	}
+	m := mref
+	for _, f := range fds[:len(fds)-1] {
+		if !m.Has(f) {
+			return reflect.Value{}, false, nil
+		}
+		m = m.Get(f).Message()
+	}
+	src := fds[len(fds)-1]
+	if !m.Has(src) {
+		return reflect.Value{}, false, nil
+	}
+	switch val := m.Get(src); {
+	case src.Kind() == reflect.BytesKind:
+		return reflect.ValueOfBytes(append([]byte(nil), val.Bytes()...)), true, nil
+	case src.Message() != nil:
+		return reflect.ValueOfMessage(proto.Clone(val.Message().Interface()).ProtoReflect()), true, nil
+	default:
+		return val, true, nil
+	}
+}
+
+// FieldType returns the type name of the values of the field fd, the message full name or the kind,
+// as used in the errors of the values not matching the field type, e.g. by the expr package.
+func FieldType(fd reflect.FieldDescriptor) string {
+	if fd.Message() != nil {
+		return string(fd.Message().FullName())
+	}
+	return fd.Kind().String()
+}
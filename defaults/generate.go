@@ -0,0 +1,142 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+	"github.com/rs/xid"
+)
+
+// DefaultRandomLength is the number of random bytes generated by RANDOM_HEX
+// when no length is specified.
+const DefaultRandomLength = 16
+
+// Generate returns the textual representation of a new value produced by the generator g.
+// The length is the number of random bytes used by RANDOM_HEX.
+func Generate(g Generator, length uint32) (string, error) {
+	switch g {
+	case Generator_RANDOM_HEX:
+		b, err := randomBytes(length)
+		if err != nil {
+			return "", err
+		}
+		return hex.EncodeToString(b), nil
+	case Generator_OBJECT_ID:
+		return hex.EncodeToString(objectID()), nil
+	case Generator_HOSTNAME:
+		return os.Hostname()
+	}
+	b, err := GenerateBytes(g, length)
+	if err != nil {
+		return "", err
+	}
+	switch g {
+	case Generator_UUID_V4, Generator_UUID_V7:
+		return uuid.Must(uuid.FromBytes(b)).String(), nil
+	case Generator_ULID:
+		var id ulid.ULID
+		copy(id[:], b)
+		return id.String(), nil
+	case Generator_XID:
+		id, err := xid.FromBytes(b)
+		if err != nil {
+			return "", err
+		}
+		return id.String(), nil
+	}
+	return "", fmt.Errorf("unsupported generator: %v", g)
+}
+
+// GenerateBytes returns the binary representation of a new value produced by the generator g.
+// The length is the number of random bytes used by RANDOM_HEX.
+func GenerateBytes(g Generator, length uint32) ([]byte, error) {
+	switch g {
+	case Generator_UUID_V4:
+		id, err := uuid.NewRandom()
+		if err != nil {
+			return nil, err
+		}
+		return id[:], nil
+	case Generator_UUID_V7:
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+		return id[:], nil
+	case Generator_ULID:
+		id := ulid.Make()
+		return id[:], nil
+	case Generator_XID:
+		return xid.New().Bytes(), nil
+	case Generator_OBJECT_ID:
+		return objectID(), nil
+	case Generator_RANDOM_HEX:
+		return randomBytes(length)
+	case Generator_HOSTNAME:
+		h, err := os.Hostname()
+		if err != nil {
+			return nil, err
+		}
+		return []byte(h), nil
+	}
+	return nil, fmt.Errorf("unsupported generator: %v", g)
+}
+
+func randomBytes(n uint32) ([]byte, error) {
+	if n == 0 {
+		n = DefaultRandomLength
+	}
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+var (
+	objectIDProcess [5]byte
+	objectIDCounter uint32
+)
+
+func init() {
+	var b [4]byte
+	if _, err := rand.Read(objectIDProcess[:]); err != nil {
+		panic(err)
+	}
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	objectIDCounter = binary.BigEndian.Uint32(b[:])
+}
+
+// objectID returns a new MongoDB ObjectId: a 4 bytes timestamp,
+// a 5 bytes process unique random value and a 3 bytes counter.
+func objectID() []byte {
+	b := make([]byte, 12)
+	binary.BigEndian.PutUint32(b[0:4], uint32(time.Now().Unix()))
+	copy(b[4:9], objectIDProcess[:])
+	c := atomic.AddUint32(&objectIDCounter, 1)
+	b[9], b[10], b[11] = byte(c>>16), byte(c>>8), byte(c)
+	return b
+}
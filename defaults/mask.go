@@ -0,0 +1,147 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ApplyMask applies the defaults to the fields of m selected by the paths of fm and to their
+// sub fields, as ApplyE does. Unlike WithFieldMask, an empty mask selects no field.
+//
+// The paths are field names separated by dots, e.g. `policy.max_attempts`. The paths of repeated
+// and map fields apply to all their elements, and the name of a oneof selects all its members.
+// An error is returned if a path does not match the fields of m.
+func ApplyMask(m proto.Message, fm *fieldmaskpb.FieldMask) error {
+	if m == nil || len(fm.GetPaths()) == 0 {
+		return nil
+	}
+	mask, err := resolveMask(m.ProtoReflect().Descriptor(), fm.GetPaths())
+	if err != nil {
+		return err
+	}
+	return applyMask(m.ProtoReflect(), mask)
+}
+
+// Reset clears the fields of m selected by the paths of fm, as ApplyMask selects them,
+// and applies their defaults again. Naming a oneof resets it to its default member.
+func Reset(m proto.Message, fm *fieldmaskpb.FieldMask) error {
+	if m == nil || len(fm.GetPaths()) == 0 {
+		return nil
+	}
+	mask, err := resolveMask(m.ProtoReflect().Descriptor(), fm.GetPaths())
+	if err != nil {
+		return err
+	}
+	clearMask(m.ProtoReflect(), mask)
+	return applyMask(m.ProtoReflect(), mask)
+}
+
+func applyMask(mref reflect.Message, mask fieldMask) error {
+	o := &options{now: time.Now, resolver: protoregistry.GlobalTypes, mask: mask}
+	return scope{options: o, depth: 1, mask: mask}.apply(mref).Err()
+}
+
+// clearMask clears the fields of mref selected by mask, recursing into the messages
+// held by the fields having sub paths.
+func clearMask(mref reflect.Message, mask fieldMask) {
+	fields := mref.Descriptor().Fields()
+	for name, sub := range mask {
+		f := fields.ByName(reflect.Name(name))
+		if sub == nil {
+			mref.Clear(f)
+			continue
+		}
+		if !mref.Has(f) {
+			continue
+		}
+		switch {
+		case f.IsList():
+			l := mref.Get(f).List()
+			for i := 0; i < l.Len(); i++ {
+				if v := l.Get(i).Message(); v.IsValid() {
+					clearMask(v, sub)
+				}
+			}
+		case f.IsMap():
+			mref.Get(f).Map().Range(func(_ reflect.MapKey, v reflect.Value) bool {
+				if v.Message().IsValid() {
+					clearMask(v.Message(), sub)
+				}
+				return true
+			})
+		default:
+			clearMask(mref.Mutable(f).Message(), sub)
+		}
+	}
+}
+
+// resolveMask returns the mask of the paths, checked against the fields of md,
+// the oneof names being replaced by the names of their members.
+func resolveMask(md reflect.MessageDescriptor, paths []string) (fieldMask, error) {
+	var fields []string
+	for _, p := range paths {
+		v, err := resolvePath(md, strings.Split(strings.TrimSpace(p), "."))
+		if err != nil {
+			return nil, fmt.Errorf("invalid field mask path %q: %w", p, err)
+		}
+		fields = append(fields, v...)
+	}
+	return newFieldMask(fields), nil
+}
+
+func resolvePath(md reflect.MessageDescriptor, parts []string) ([]string, error) {
+	name := reflect.Name(parts[0])
+	f := md.Fields().ByName(name)
+	if f == nil {
+		o := md.Oneofs().ByName(name)
+		if o == nil || o.IsSynthetic() {
+			return nil, fmt.Errorf("%s has no field %s", md.FullName(), name)
+		}
+		if len(parts) > 1 {
+			return nil, fmt.Errorf("oneof %s has no sub fields", o.FullName())
+		}
+		var names []string
+		for i := 0; i < o.Fields().Len(); i++ {
+			names = append(names, string(o.Fields().Get(i).Name()))
+		}
+		return names, nil
+	}
+	if len(parts) == 1 {
+		return []string{string(name)}, nil
+	}
+	sub := f.Message()
+	if f.IsMap() {
+		sub = f.MapValue().Message()
+	}
+	if sub == nil {
+		return nil, fmt.Errorf("field %s is not a message", f.FullName())
+	}
+	paths, err := resolvePath(sub, parts[1:])
+	if err != nil {
+		return nil, err
+	}
+	for i := range paths {
+		paths[i] = string(name) + "." + paths[i]
+	}
+	return paths, nil
+}
@@ -0,0 +1,184 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// Option configures ApplyWithOptions.
+type Option func(o *options)
+
+type options struct {
+	override bool
+	maxDepth int
+	now      func() time.Time
+	resolver *protoregistry.Types
+	noInit   bool
+	mask     fieldMask
+	report   func(p FieldPath)
+	proto2   bool
+}
+
+// WithOverride sets the defaults even if the fields are already set:
+// the values replace the existing ones, repeated defaults replace the lists,
+// and map entries replace the existing entries, or the whole map if it is not merged.
+// The default member of a oneof is still only set if no other member is.
+func WithOverride() Option {
+	return func(o *options) {
+		o.override = true
+	}
+}
+
+// WithMaxDepth limits the application of the defaults to the messages nested
+// at most n levels deep, the message passed to ApplyWithOptions being at level 1.
+// Deeper messages may still be initialized, but their defaults are not applied.
+// Zero, the default, means no limit.
+func WithMaxDepth(n int) Option {
+	return func(o *options) {
+		o.maxDepth = n
+	}
+}
+
+// WithClock sets the function returning the current time, used by the `now`
+// and relative timestamps. It defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(o *options) {
+		if now != nil {
+			o.now = now
+		}
+	}
+}
+
+// WithResolver sets the types used to resolve the google.protobuf.Any values
+// and the message literals extensions. It defaults to protoregistry.GlobalTypes.
+func WithResolver(r *protoregistry.Types) Option {
+	return func(o *options) {
+		if r != nil {
+			o.resolver = r
+		}
+	}
+}
+
+// WithoutInitialize ignores the `initialize` message rules: the unset message fields
+// and the nil elements are left as is. Message literals are still set.
+func WithoutInitialize() Option {
+	return func(o *options) {
+		o.noInit = true
+	}
+}
+
+// WithFieldMask restricts the defaults to the fields of the mask paths and their sub fields,
+// e.g. `policy.max_attempts` or `labels`. The paths of repeated and map fields apply to all their elements.
+// A nil or empty mask selects all the fields.
+func WithFieldMask(fm *fieldmaskpb.FieldMask) Option {
+	return func(o *options) {
+		o.mask = newFieldMask(fm.GetPaths())
+	}
+}
+
+// WithProto2Defaults uses the native proto2 default values as defaults rules for the fields
+// without (defaults.value), as the (defaults.proto2_defaults) file option does.
+func WithProto2Defaults() Option {
+	return func(o *options) {
+		o.proto2 = true
+	}
+}
+
+// ApplyWithOptions applies the defaults to m as ApplyE does, configured by opts.
+func ApplyWithOptions(m proto.Message, opts ...Option) error {
+	if m == nil {
+		return nil
+	}
+	o := &options{now: time.Now, resolver: protoregistry.GlobalTypes}
+	for _, v := range opts {
+		v(o)
+	}
+	return scope{options: o, depth: 1, mask: o.mask}.apply(m.ProtoReflect()).Err()
+}
+
+// scope is the state of the walk of a message: the options, the depth
+// of the message, its path and the field mask relative to it.
+type scope struct {
+	*options
+	depth int
+	path  string
+	mask  fieldMask
+}
+
+// field returns the scope of the field name and of the messages it holds,
+// and whether the field is selected by the mask.
+func (s scope) field(name string) (scope, bool) {
+	c := scope{options: s.options, depth: s.depth + 1, path: joinPath(s.path, name)}
+	if s.mask == nil {
+		return c, true
+	}
+	sub, ok := s.mask[name]
+	c.mask = sub
+	return c, ok
+}
+
+// key returns the scope of the element of the repeated or map field of s at the given index or key.
+func (s scope) key(key interface{}) scope {
+	s.path = string(KeyPath(s.path, key))
+	return s
+}
+
+// reportSet reports that the field or element of s has been set.
+func (s scope) reportSet() {
+	if s.report != nil {
+		s.report(FieldPath(s.path))
+	}
+}
+
+// exceeded reports whether the message of the scope is deeper than the maximum depth.
+func (s scope) exceeded() bool {
+	return s.maxDepth > 0 && s.depth > s.maxDepth
+}
+
+// fieldMask is a tree of field names, a nil sub tree selecting all the sub fields.
+type fieldMask map[string]fieldMask
+
+func newFieldMask(paths []string) fieldMask {
+	if len(paths) == 0 {
+		return nil
+	}
+	mask := fieldMask{}
+	for _, p := range paths {
+		n := mask
+		parts := strings.Split(strings.TrimSpace(p), ".")
+		for i, v := range parts {
+			sub, ok := n[v]
+			if ok && sub == nil {
+				break
+			}
+			if i == len(parts)-1 {
+				n[v] = nil
+				break
+			}
+			if !ok {
+				sub = fieldMask{}
+				n[v] = sub
+			}
+			n = sub
+		}
+	}
+	return mask
+}
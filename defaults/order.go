@@ -0,0 +1,134 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// FieldOrder returns the fields of md in the order their defaults are applied: the declaration order,
+// the fields being moved after the fields they depend on, i.e. the fields they are copied from,
+// the fields their conditions are evaluated on and the fields their expressions reference.
+// An error is returned if the fields depend on each other, the fields of the cycle being
+// left in declaration order at the end of the returned fields.
+func FieldOrder(md reflect.MessageDescriptor) ([]reflect.FieldDescriptor, error) {
+	order, cycle := fieldOrder(md)
+	if cycle != nil {
+		return order, cycleError(cycle)
+	}
+	return order, nil
+}
+
+func fieldOrder(md reflect.MessageDescriptor) (order, cycle []reflect.FieldDescriptor) {
+	fields := md.Fields()
+	deps := make(map[reflect.FieldNumber][]reflect.FieldDescriptor, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		deps[fields.Get(i).Number()] = fieldDeps(fields.Get(i))
+	}
+	placed := make(map[reflect.FieldNumber]bool, fields.Len())
+	ready := func(f reflect.FieldDescriptor) bool {
+		for _, d := range deps[f.Number()] {
+			if !placed[d.Number()] {
+				return false
+			}
+		}
+		return true
+	}
+	for len(order) < fields.Len() {
+		var next reflect.FieldDescriptor
+		for i := 0; i < fields.Len() && next == nil; i++ {
+			if f := fields.Get(i); !placed[f.Number()] && ready(f) {
+				next = f
+			}
+		}
+		if next == nil {
+			break
+		}
+		placed[next.Number()] = true
+		order = append(order, next)
+	}
+	if len(order) == fields.Len() {
+		return order, nil
+	}
+	var rest []reflect.FieldDescriptor
+	for i := 0; i < fields.Len(); i++ {
+		if f := fields.Get(i); !placed[f.Number()] {
+			rest = append(rest, f)
+		}
+	}
+	return append(order, rest...), findCycle(rest[0], deps, placed)
+}
+
+// findCycle returns the fields of the cycle reached from the field f by following the dependencies
+// not placed yet, the first field being repeated at the end.
+func findCycle(f reflect.FieldDescriptor, deps map[reflect.FieldNumber][]reflect.FieldDescriptor, placed map[reflect.FieldNumber]bool) []reflect.FieldDescriptor {
+	var path []reflect.FieldDescriptor
+	seen := make(map[reflect.FieldNumber]int)
+	for {
+		if i, ok := seen[f.Number()]; ok {
+			return append(path[i:], f)
+		}
+		seen[f.Number()] = len(path)
+		path = append(path, f)
+		for _, d := range deps[f.Number()] {
+			if !placed[d.Number()] {
+				f = d
+				break
+			}
+		}
+	}
+}
+
+func cycleError(cycle []reflect.FieldDescriptor) error {
+	var names []string
+	for _, f := range cycle {
+		names = append(names, string(f.Name()))
+	}
+	return fmt.Errorf("dependency cycle: %s", strings.Join(names, " -> "))
+}
+
+// fieldDeps returns the sibling fields the defaults of the field f depend on.
+// The invalid rules are ignored, their errors being reported when the defaults are applied.
+func fieldDeps(f reflect.FieldDescriptor) []reflect.FieldDescriptor {
+	rules := []*FieldDefaults{proto.GetExtension(f.Options(), E_Value).(*FieldDefaults)}
+	var names []string
+	for _, w := range proto.GetExtension(f.Options(), E_When).([]*When) {
+		names = append(names, w.GetField())
+		rules = append(rules, w.GetValue())
+	}
+	for _, r := range rules {
+		switch {
+		case r.GetFromField() != "":
+			names = append(names, strings.SplitN(strings.TrimSpace(r.GetFromField()), ".", 2)[0])
+		case r.GetExpr() != "":
+			names = append(names, exprDeps(f, r.GetExpr())...)
+		}
+	}
+	var deps []reflect.FieldDescriptor
+	seen := make(map[reflect.FieldNumber]bool)
+	for _, name := range names {
+		d := f.ContainingMessage().Fields().ByName(reflect.Name(name))
+		if d == nil || d.Number() == f.Number() || seen[d.Number()] {
+			continue
+		}
+		seen[d.Number()] = true
+		deps = append(deps, d)
+	}
+	return deps
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// plans caches the plans by planKey.
+var plans sync.Map
+
+// planKey identifies the plan of a message type, with or without the native proto2 defaults.
+type planKey struct {
+	md     reflect.MessageDescriptor
+	proto2 bool
+}
+
+// plan holds the defaults of a message type, read once from the descriptor options.
+type plan struct {
+	// skip is set for the disabled and ignored messages.
+	skip   bool
+	fields []*fieldPlan
+}
+
+// fieldPlan holds the defaults of a field.
+type fieldPlan struct {
+	fd    reflect.FieldDescriptor
+	name  string
+	rules *FieldDefaults
+	// err is set if the rules cannot be read.
+	err error
+	// oneof is set if the field is not in a oneof, or is the default member of its oneof.
+	oneof bool
+	// value is the singular value, items the repeated items and keys / values the map entries.
+	value        *valuePlan
+	items        []*valuePlan
+	keys, values []*valuePlan
+	// when holds the conditional defaults, rules being nil if the field only has conditional defaults.
+	when []*whenPlan
+}
+
+// whenPlan is a conditional default of a field.
+type whenPlan struct {
+	fd reflect.FieldDescriptor
+	// equals is the value the field must be equal to, the field presence being checked against has if it is invalid.
+	equals reflect.Value
+	has    bool
+	plan   *fieldPlan
+}
+
+// valuePlan is the default value of a field element. It is computed once when it depends
+// neither on the options nor on the environment, e.g. scalars, durations or absolute timestamps.
+type valuePlan struct {
+	rules  *FieldDefaults
+	static bool
+	once   sync.Once
+	value  reflect.Value
+	ok     bool
+	err    error
+}
+
+// planOf returns the plan of the message type md, built on first use. If proto2 is set,
+// the native proto2 default values are used as rules, as if the file enabled them.
+func planOf(md reflect.MessageDescriptor, proto2 bool) *plan {
+	k := planKey{md: md, proto2: proto2 || proto2Enabled(md.ParentFile())}
+	if p, ok := plans.Load(k); ok {
+		return p.(*plan)
+	}
+	p, _ := plans.LoadOrStore(k, newPlan(md, k.proto2))
+	return p.(*plan)
+}
+
+func newPlan(md reflect.MessageDescriptor, proto2 bool) *plan {
+	opts := md.Options()
+	if proto.GetExtension(opts, E_Disabled).(bool) || proto.GetExtension(opts, E_Ignored).(bool) {
+		return &plan{skip: true}
+	}
+	p := &plan{}
+	order, cycle := fieldOrder(md)
+	inCycle := make(map[reflect.FieldNumber]bool, len(cycle))
+	for _, f := range cycle {
+		inCycle[f.Number()] = true
+	}
+	for _, f := range order {
+		fp := &fieldPlan{fd: f, name: string(f.Name()), oneof: true}
+		if inCycle[f.Number()] {
+			fp.err = cycleError(cycle)
+			p.fields = append(p.fields, fp)
+			continue
+		}
+		ext := proto.GetExtension(f.Options(), E_Value)
+		fd, ok := ext.(*FieldDefaults)
+		if !ok {
+			fp.err = fmt.Errorf("unexpected defaults extension type: %T", ext)
+			p.fields = append(p.fields, fp)
+			continue
+		}
+		if fd.GetType() == nil && proto2 {
+			fd = Proto2Rules(f)
+		}
+		if fp.when, fp.err = newWhenPlans(f); fp.err != nil {
+			p.fields = append(p.fields, fp)
+			continue
+		}
+		if fd.GetType() == nil && len(fp.when) == 0 {
+			continue
+		}
+		if oo := f.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+			fp.oneof = proto.GetExtension(oo.Options(), E_Oneof).(string) == fp.name
+		}
+		if fd.GetType() != nil {
+			fp.setRules(fd)
+		}
+		p.fields = append(p.fields, fp)
+	}
+	return p
+}
+
+// setRules sets the rules fd of the field and their values.
+func (fp *fieldPlan) setRules(fd *FieldDefaults) {
+	fp.rules = fd
+	fp.value = newValuePlan(fd)
+	for _, v := range fd.GetRepeated().GetItems() {
+		fp.items = append(fp.items, newValuePlan(v))
+	}
+	for _, e := range fd.GetMap().GetEntries() {
+		fp.keys = append(fp.keys, newValuePlan(e.GetKey()))
+		fp.values = append(fp.values, newValuePlan(e.GetValue()))
+	}
+}
+
+// newWhenPlans returns the conditional defaults of the field f, resolved against its siblings.
+func newWhenPlans(f reflect.FieldDescriptor) ([]*whenPlan, error) {
+	when := proto.GetExtension(f.Options(), E_When).([]*When)
+	if len(when) == 0 {
+		return nil, nil
+	}
+	if oo := f.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+		return nil, fmt.Errorf("when cannot be used on oneof fields")
+	}
+	var plans []*whenPlan
+	for _, v := range when {
+		sf := f.ContainingMessage().Fields().ByName(reflect.Name(v.GetField()))
+		if sf == nil || sf == f {
+			return nil, fmt.Errorf("when: %s has no sibling field %q", f.ContainingMessage().FullName(), v.GetField())
+		}
+		w := &whenPlan{fd: sf}
+		switch c := v.GetCondition().(type) {
+		case *When_Equals:
+			if sf.IsList() || sf.IsMap() || sf.Kind() == reflect.MessageKind || sf.Kind() == reflect.GroupKind {
+				return nil, fmt.Errorf("when: equals cannot be used on %s", sf.FullName())
+			}
+			ev, err := ParseValue(sf, c.Equals)
+			if err != nil {
+				return nil, fmt.Errorf("when: %s: %w", sf.FullName(), err)
+			}
+			w.equals = ev
+		case *When_Has:
+			w.has = c.Has
+		default:
+			return nil, fmt.Errorf("when: missing %s condition", sf.FullName())
+		}
+		if v.GetValue().GetType() == nil {
+			return nil, fmt.Errorf("when: missing %s value", sf.FullName())
+		}
+		w.plan = &fieldPlan{fd: f, name: string(f.Name()), oneof: true}
+		w.plan.setRules(v.GetValue())
+		plans = append(plans, w)
+	}
+	return plans, nil
+}
+
+// holds reports whether the condition of w holds for the message m.
+func (w *whenPlan) holds(m reflect.Message) bool {
+	if !w.equals.IsValid() {
+		return m.Has(w.fd) == w.has
+	}
+	return equalValue(w.fd, m.Get(w.fd), w.equals)
+}
+
+// resolve returns the plan of the first conditional default of fp holding for the message m,
+// fp if none holds, or nil if none holds and the field only has conditional defaults.
+func (fp *fieldPlan) resolve(m reflect.Message) *fieldPlan {
+	for _, w := range fp.when {
+		if w.holds(m) {
+			return w.plan
+		}
+	}
+	if fp.rules == nil {
+		return nil
+	}
+	return fp
+}
+
+// newValuePlan returns the plan of the value described by fd. Only the constant scalar values
+// are computed once, the messages and lists being built for each message.
+func newValuePlan(fd *FieldDefaults) *valuePlan {
+	switch fd.GetType().(type) {
+	case *FieldDefaults_Any, *FieldDefaults_Message, *FieldDefaults_Repeated, *FieldDefaults_Map, nil:
+		return &valuePlan{rules: fd}
+	}
+	return &valuePlan{rules: fd, static: IsConstant(fd)}
+}
+
+// fieldValue returns the value described by fp for the singular field of fp in mref,
+// the expressions being evaluated against mref and the fields being copied from mref.
+func (s scope) fieldValue(mref reflect.Message, fp *fieldPlan, n reflect.Value) (reflect.Value, bool, error) {
+	switch r := fp.rules.GetType().(type) {
+	case *FieldDefaults_Expr:
+		return exprValue(mref, fp.fd, r.Expr)
+	case *FieldDefaults_FromField:
+		return fromFieldValue(mref, fp.fd, r.FromField)
+	}
+	return s.planValue(fp.fd, fp.value, n)
+}
+
+// planValue returns the value described by p for a single element of the field f as value does,
+// the static values being computed once and copied.
+func (s scope) planValue(f reflect.FieldDescriptor, p *valuePlan, n reflect.Value) (reflect.Value, bool, error) {
+	if !p.static {
+		return s.value(f, p.rules, n)
+	}
+	p.once.Do(func() {
+		p.value, p.ok, p.err = s.value(f, p.rules, n)
+	})
+	if p.err != nil || !p.ok {
+		return reflect.Value{}, p.ok, p.err
+	}
+	switch v := p.value.Interface().(type) {
+	case []byte:
+		return reflect.ValueOf(append([]byte(nil), v...)), true, nil
+	case reflect.Message:
+		// the value may have been computed for another implementation of the message type, e.g. dynamicpb
+		if n.IsValid() && v.Type() != n.Message().Type() {
+			return s.value(f, p.rules, n)
+		}
+		return reflect.ValueOf(proto.Clone(v.Interface()).ProtoReflect()), true, nil
+	}
+	return p.value, true, nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"math"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Proto2Rules returns the rules setting the native default value of the proto2 field fd,
+// declared with [default = ...], or nil if it has none. The infinite and NaN floating point
+// values are not supported.
+func Proto2Rules(fd reflect.FieldDescriptor) *FieldDefaults {
+	if !fd.HasDefault() || fd.Cardinality() == reflect.Repeated {
+		return nil
+	}
+	v := fd.Default()
+	switch fd.Kind() {
+	case reflect.BoolKind:
+		return &FieldDefaults{Type: &FieldDefaults_Bool{Bool: v.Bool()}}
+	case reflect.EnumKind:
+		if ev := fd.DefaultEnumValue(); ev != nil {
+			return &FieldDefaults{Type: &FieldDefaults_EnumName{EnumName: string(ev.Name())}}
+		}
+	case reflect.Int32Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Int32{Int32: int32(v.Int())}}
+	case reflect.Sint32Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Sint32{Sint32: int32(v.Int())}}
+	case reflect.Sfixed32Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Sfixed32{Sfixed32: int32(v.Int())}}
+	case reflect.Int64Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Int64{Int64: v.Int()}}
+	case reflect.Sint64Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Sint64{Sint64: v.Int()}}
+	case reflect.Sfixed64Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Sfixed64{Sfixed64: v.Int()}}
+	case reflect.Uint32Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Uint32{Uint32: uint32(v.Uint())}}
+	case reflect.Fixed32Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Fixed32{Fixed32: uint32(v.Uint())}}
+	case reflect.Uint64Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Uint64{Uint64: v.Uint()}}
+	case reflect.Fixed64Kind:
+		return &FieldDefaults{Type: &FieldDefaults_Fixed64{Fixed64: v.Uint()}}
+	case reflect.FloatKind:
+		if !math.IsInf(v.Float(), 0) && !math.IsNaN(v.Float()) {
+			return &FieldDefaults{Type: &FieldDefaults_Float{Float: float32(v.Float())}}
+		}
+	case reflect.DoubleKind:
+		if !math.IsInf(v.Float(), 0) && !math.IsNaN(v.Float()) {
+			return &FieldDefaults{Type: &FieldDefaults_Double{Double: v.Float()}}
+		}
+	case reflect.StringKind:
+		return &FieldDefaults{Type: &FieldDefaults_String_{String_: v.String()}}
+	case reflect.BytesKind:
+		return &FieldDefaults{Type: &FieldDefaults_Bytes{Bytes: append([]byte(nil), v.Bytes()...)}}
+	}
+	return nil
+}
+
+// proto2Enabled reports whether the native proto2 default values of the file fd are used as rules.
+func proto2Enabled(fd reflect.FileDescriptor) bool {
+	if fd == nil {
+		return false
+	}
+	v, _ := proto.GetExtension(fd.Options(), E_Proto2Defaults).(bool)
+	return v
+}
@@ -0,0 +1,62 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"strconv"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// FieldPath is the path of a field from the message the defaults are applied to,
+// e.g. policy.max_attempts, items[0].name or labels["app"].
+type FieldPath string
+
+// ApplyReport applies the defaults to m as Apply does and returns the paths of the fields set.
+// A message field set from the defaults is reported before the fields set in it.
+func ApplyReport(m proto.Message) []FieldPath {
+	var paths []FieldPath
+	if err := ApplyWithOptions(m, WithReport(func(p FieldPath) {
+		paths = append(paths, p)
+	})); err != nil {
+		ErrorHandler(err)
+	}
+	return paths
+}
+
+// WithReport calls fn with the path of every field set while applying the defaults.
+func WithReport(fn func(p FieldPath)) Option {
+	return func(o *options) {
+		o.report = fn
+	}
+}
+
+// KeyPath returns the path of the element of the repeated or map field path at the given index or key.
+func KeyPath(path string, key interface{}) FieldPath {
+	if s, ok := key.(string); ok {
+		key = strconv.Quote(s)
+	}
+	return FieldPath(fmt.Sprintf("%s[%v]", path, key))
+}
+
+// PrefixPaths returns paths prefixed by the path of the message holding them.
+func PrefixPaths(prefix FieldPath, paths []FieldPath) []FieldPath {
+	out := make([]FieldPath, len(paths))
+	for i, v := range paths {
+		out[i] = FieldPath(joinPath(string(prefix), string(v)))
+	}
+	return out
+}
@@ -0,0 +1,223 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"bytes"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	reflect "google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Strip clears the fields of m holding their default value, recursing into the messages
+// whose defaults are applied, so that applying the defaults to the result gives m back.
+//
+// The defaults which are not constant, i.e. environment variables, generated values and
+// `now` or relative timestamps, are never stripped. Messages defined by a literal are only
+// cleared if they are equal to their default, the fields of other messages being kept.
+func Strip(m proto.Message) {
+	if m == nil {
+		return
+	}
+	s := scope{options: &options{now: time.Now, resolver: protoregistry.GlobalTypes}, depth: 1}
+	s.strip(m.ProtoReflect())
+}
+
+func (s scope) strip(mref reflect.Message) {
+	p := planOf(mref.Descriptor(), s.proto2)
+	if p.skip {
+		return
+	}
+	// the fields are walked in the reverse order of apply, so that the conditional defaults
+	// are resolved before the fields their conditions are evaluated on are stripped
+	for i := len(p.fields) - 1; i >= 0; i-- {
+		fp := p.fields[i]
+		if fp.err != nil {
+			continue
+		}
+		if fp = fp.resolve(mref); fp == nil || !mref.Has(fp.fd) {
+			continue
+		}
+		f := fp.fd
+		md := fp.rules.GetMessage()
+		if f.IsMap() && fp.rules.GetMap() != nil {
+			md = fp.rules.GetMap().GetValues()
+		}
+		switch {
+		case f.IsList():
+			if _, ok := fp.rules.GetType().(*FieldDefaults_Repeated); ok && IsConstant(fp.rules) && s.isDefault(mref, fp) {
+				mref.Clear(f)
+				continue
+			}
+			if md.GetDefaults() && md.GetLiteral() == nil {
+				l := mref.Mutable(f).List()
+				for i := 0; i < l.Len(); i++ {
+					if v := l.Get(i).Message(); v.IsValid() {
+						s.strip(v)
+					}
+				}
+			}
+		case f.IsMap():
+			if fp.rules.GetMap() != nil && IsConstant(fp.rules) {
+				s.stripEntries(mref, fp)
+				if !mref.Has(f) {
+					continue
+				}
+			}
+			if md.GetDefaults() && md.GetLiteral() == nil {
+				mref.Mutable(f).Map().Range(func(_ reflect.MapKey, v reflect.Value) bool {
+					if v.Message().IsValid() {
+						s.strip(v.Message())
+					}
+					return true
+				})
+			}
+		case md != nil && f.Kind() == reflect.MessageKind:
+			if md.GetLiteral() != nil {
+				if fp.oneof && s.isDefault(mref, fp) {
+					mref.Clear(f)
+				}
+				continue
+			}
+			if messageDefaults(md) {
+				s.strip(mref.Mutable(f).Message())
+			}
+			if fp.oneof && md.GetInitialize() && proto.Size(mref.Get(f).Message().Interface()) == 0 {
+				mref.Clear(f)
+			}
+		default:
+			if fp.oneof && IsConstant(fp.rules) && s.isDefault(mref, fp) {
+				mref.Clear(f)
+			}
+		}
+	}
+}
+
+// stripEntries removes the entries of the map field of fp holding their default value,
+// or clears the map if it is not merged and only holds the default entries.
+func (s scope) stripEntries(mref reflect.Message, fp *fieldPlan) {
+	def, ok := s.defaultValue(mref, fp)
+	if !ok {
+		return
+	}
+	mp := mref.Mutable(fp.fd).Map()
+	if !fp.rules.GetMap().GetMerge() {
+		if equal(fp.fd, mref.Get(fp.fd), def) {
+			mref.Clear(fp.fd)
+		}
+		return
+	}
+	def.Map().Range(func(k reflect.MapKey, v reflect.Value) bool {
+		if mp.Has(k) && equalValue(fp.fd.MapValue(), mp.Get(k), v) {
+			mp.Clear(k)
+		}
+		return true
+	})
+	if mp.Len() == 0 {
+		mref.Clear(fp.fd)
+	}
+}
+
+// isDefault reports whether the field of fp holds the value set by the defaults to an unset field.
+func (s scope) isDefault(mref reflect.Message, fp *fieldPlan) bool {
+	v, ok := s.defaultValue(mref, fp)
+	return ok && equal(fp.fd, mref.Get(fp.fd), v)
+}
+
+// defaultValue returns the value set by the constant defaults of fp to the field of an unset
+// message of the type of mref, or false if it is not set or depends on the other fields,
+// the environment, a generator or the time. Only the field of fp is applied.
+func (s scope) defaultValue(mref reflect.Message, fp *fieldPlan) (reflect.Value, bool) {
+	if fp.fd.ContainingOneof() != nil && !fp.oneof || !IsConstant(fp.rules) {
+		return reflect.Value{}, false
+	}
+	c := s
+	c.mask = nil
+	fs, _ := c.field(fp.name)
+	n := mref.New()
+	if err := fs.applyField(n, fp); err != nil || !n.Has(fp.fd) {
+		return reflect.Value{}, false
+	}
+	return n.Get(fp.fd), true
+}
+
+// IsConstant reports whether the value described by fd is always the same, i.e. whether it
+// does not depend on the environment, on the other fields, on a generator or on the time.
+// It is used by both Strip and the generated StripDefaults methods.
+func IsConstant(fd *FieldDefaults) bool {
+	switch r := fd.GetType().(type) {
+	case *FieldDefaults_Env, *FieldDefaults_Generate, *FieldDefaults_Expr, *FieldDefaults_FromField:
+		return false
+	case *FieldDefaults_Timestamp:
+		_, err := parseTime(strings.TrimSpace(r.Timestamp))
+		return err == nil
+	case *FieldDefaults_Repeated:
+		for _, v := range r.Repeated.GetItems() {
+			if !IsConstant(v) {
+				return false
+			}
+		}
+	case *FieldDefaults_Map:
+		for _, v := range r.Map.GetEntries() {
+			if !IsConstant(v.GetKey()) || !IsConstant(v.GetValue()) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// equal reports whether the values a and b of the field f are equal.
+func equal(f reflect.FieldDescriptor, a, b reflect.Value) bool {
+	switch {
+	case f.IsList():
+		la, lb := a.List(), b.List()
+		if la.Len() != lb.Len() {
+			return false
+		}
+		for i := 0; i < la.Len(); i++ {
+			if !equalValue(f, la.Get(i), lb.Get(i)) {
+				return false
+			}
+		}
+		return true
+	case f.IsMap():
+		ma, mb := a.Map(), b.Map()
+		if ma.Len() != mb.Len() {
+			return false
+		}
+		eq := true
+		ma.Range(func(k reflect.MapKey, v reflect.Value) bool {
+			eq = mb.Has(k) && equalValue(f.MapValue(), v, mb.Get(k))
+			return eq
+		})
+		return eq
+	}
+	return equalValue(f, a, b)
+}
+
+// equalValue reports whether the single values a and b of the field f are equal.
+func equalValue(f reflect.FieldDescriptor, a, b reflect.Value) bool {
+	switch f.Kind() {
+	case reflect.MessageKind, reflect.GroupKind:
+		return proto.Equal(a.Message().Interface(), b.Message().Interface())
+	case reflect.BytesKind:
+		return bytes.Equal(a.Bytes(), b.Bytes())
+	}
+	return a.Interface() == b.Interface()
+}
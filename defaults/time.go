@@ -0,0 +1,119 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package defaults
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+var relativeTimeRE = regexp.MustCompile(`^(?i:(now|today|tomorrow|yesterday))` +
+	`(?:\s+(\d{1,2}):(\d{2})(?::(\d{2}))?)?` +
+	`(.*)$`)
+
+// RelativeTime returns the time described by the relative timestamp expression s, evaluated at now.
+//
+// The expression is made of an anchor, `now`, `today`, `tomorrow` or `yesterday`, optionally followed
+// for the day anchors by a time of day (`HH:MM[:SS]`, defaults to 00:00) and a time zone name
+// (defaults to UTC), and by an offset using the Prometheus duration format, e.g.
+// `now+30d`, `now-1h`, `today`, `tomorrow 00:00 UTC` or `today 08:00 Europe/Paris+1w`.
+func RelativeTime(s string, now time.Time) (time.Time, error) {
+	match := relativeTimeRE.FindStringSubmatch(strings.TrimSpace(s))
+	if match == nil {
+		return time.Time{}, fmt.Errorf("invalid relative timestamp: %q", s)
+	}
+	anchor, hour, minute, second := strings.ToLower(match[1]), match[2], match[3], match[4]
+	loc, offset, err := zoneOffset(match[5])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid relative timestamp: %q: %w", s, err)
+	}
+	var t time.Time
+	if anchor == "now" {
+		if hour != "" || loc != nil {
+			return time.Time{}, fmt.Errorf("invalid relative timestamp: %q: time of day and zone cannot be used with now", s)
+		}
+		t = now
+	} else {
+		if loc == nil {
+			loc = time.UTC
+		}
+		var h, m, sec int
+		if hour != "" {
+			h, _ = strconv.Atoi(hour)
+			m, _ = strconv.Atoi(minute)
+			sec, _ = strconv.Atoi(second)
+			if h > 23 || m > 59 || sec > 59 {
+				return time.Time{}, fmt.Errorf("invalid relative timestamp: %q: invalid time of day", s)
+			}
+		}
+		days := map[string]int{"today": 0, "tomorrow": 1, "yesterday": -1}[anchor]
+		y, mo, d := now.In(loc).Date()
+		t = time.Date(y, mo, d+days, h, m, sec, 0, loc)
+	}
+	return t.Add(offset), nil
+}
+
+// zoneOffset parses the optional time zone name, preceded by spaces, and the optional signed offset ending
+// a relative timestamp expression. As the zone names may contain signs, e.g. Etc/GMT+5, the longest name
+// known by time.LoadLocation is used.
+func zoneOffset(s string) (*time.Location, time.Duration, error) {
+	rest := strings.TrimSpace(s)
+	switch {
+	case rest == "":
+		return nil, 0, nil
+	case rest[0] == '+' || rest[0] == '-':
+		d, err := parseOffset(rest)
+		return nil, d, err
+	case rest == s:
+		return nil, 0, fmt.Errorf("unexpected %q", s)
+	}
+	for i := len(rest); i > 0; i-- {
+		if i < len(rest) && rest[i] != '+' && rest[i] != '-' {
+			continue
+		}
+		zone := strings.TrimSpace(rest[:i])
+		if strings.ContainsAny(zone, " \t") {
+			continue
+		}
+		var d time.Duration
+		if i < len(rest) {
+			var err error
+			if d, err = parseOffset(rest[i:]); err != nil {
+				continue
+			}
+		}
+		if loc, err := time.LoadLocation(zone); err == nil {
+			return loc, d, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("unknown time zone or invalid offset: %q", rest)
+}
+
+// parseOffset parses the signed Prometheus duration s.
+func parseOffset(s string) (time.Duration, error) {
+	d, err := model.ParseDuration(strings.TrimSpace(s[1:]))
+	if err != nil {
+		return 0, err
+	}
+	if s[0] == '-' {
+		return -time.Duration(d), nil
+	}
+	return time.Duration(d), nil
+}
@@ -1,12 +1,29 @@
 module go.linka.cloud/protoc-gen-defaults
 
-go 1.16
+go 1.21.1
 
 require (
-	github.com/golang/protobuf v1.5.2
+	github.com/google/cel-go v0.22.0
+	github.com/google/uuid v1.6.0
 	github.com/lyft/protoc-gen-star v0.6.0
+	github.com/oklog/ulid/v2 v2.1.0
 	github.com/prometheus/common v0.29.0
+	github.com/rs/xid v1.6.0
 	github.com/stretchr/testify v1.7.0
-	google.golang.org/protobuf v1.26.0
+	google.golang.org/protobuf v1.34.2
+)
+
+require (
+	cel.dev/expr v0.18.0 // indirect
+	github.com/antlr4-go/antlr/v4 v4.13.0 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.4 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/spf13/afero v1.3.3 // indirect
+	github.com/stoewer/go-strcase v1.2.0 // indirect
+	golang.org/x/exp v0.0.0-20230515195305-f3d0a9c9a5cc // indirect
+	golang.org/x/text v0.16.0 // indirect
+	google.golang.org/genproto/googleapis/api v0.0.0-20240826202546-f6391c0de4c7 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20240826202546-f6391c0de4c7 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b // indirect
 )
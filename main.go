@@ -15,21 +15,54 @@
 package main
 
 import (
+	"bytes"
+	"log"
+	"os"
+
 	pgs "github.com/lyft/protoc-gen-star"
 	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/pluginpb"
 
 	"go.linka.cloud/protoc-gen-defaults/module"
 )
 
+const (
+	minimumEdition = descriptorpb.Edition_EDITION_PROTO2
+	maximumEdition = descriptorpb.Edition_EDITION_2023
+)
+
 func main() {
-	feat := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL)
+	feat := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+	var out bytes.Buffer
 	pgs.Init(
 		pgs.DebugEnv("DEBUG"),
 		pgs.SupportedFeatures(&feat),
+		pgs.ProtocOutput(&out),
 	).RegisterModule(
 		module.Defaults(),
 	).RegisterPostProcessor(
 		pgsgo.GoFmt(),
 	).Render()
+	if err := writeResponse(out.Bytes()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeResponse writes the response generated by protoc-gen-star to the standard output,
+// declaring the range of the supported editions that it cannot set.
+func writeResponse(b []byte) error {
+	var resp pluginpb.CodeGeneratorResponse
+	if err := proto.Unmarshal(b, &resp); err != nil {
+		return err
+	}
+	resp.MinimumEdition = proto.Int32(int32(minimumEdition))
+	resp.MaximumEdition = proto.Int32(int32(maximumEdition))
+	b, err := proto.Marshal(&resp)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(b)
+	return err
 }
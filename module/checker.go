@@ -15,7 +15,9 @@
 package module
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	pgs "github.com/lyft/protoc-gen-star"
 	"github.com/prometheus/common/model"
@@ -23,6 +25,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.linka.cloud/protoc-gen-defaults/defaults"
+	"go.linka.cloud/protoc-gen-defaults/defaults/expr"
 )
 
 // Heavily taken from https://github.com/envoyproxy/protoc-gen-validate/blob/main/module/checker.go
@@ -49,19 +52,27 @@ func (m *Module) Check(msg pgs.Message) {
 		return
 	}
 
+	_, err = defaults.FieldOrder(m.messageDescriptor(msg))
+	m.CheckErr(err, "unable to order fields")
+
 	for _, f := range msg.Fields() {
 		m.Push(f.Name().String())
 
-		var fieldDefaults defaults.FieldDefaults
-		_, err = f.Extension(defaults.E_Value, &fieldDefaults)
+		fieldDefaults := &defaults.FieldDefaults{}
+		_, err = f.Extension(defaults.E_Value, fieldDefaults)
 		m.CheckErr(err, "unable to read defaults from field")
 
+		m.CheckProto2(f, fieldDefaults)
+		if native := m.proto2Rules(f); fieldDefaults.Type == nil && native != nil {
+			fieldDefaults = native
+		}
+
 		if fieldDefaults.GetMessage() != nil {
-			m.MustType(f.Type(), pgs.MessageT, pgs.UnknownWKT)
-			m.CheckMessage(f, &fieldDefaults)
+			m.CheckMessage(f, fieldDefaults)
 		}
 
-		m.CheckFieldRules(f.Type(), &fieldDefaults)
+		m.CheckFieldRules(f.Type(), fieldDefaults)
+		m.CheckWhen(f)
 
 		if f.InRealOneOf() {
 			m.CheckOneOf(f.OneOf())
@@ -124,12 +135,45 @@ func (m *Module) CheckFieldRules(typ FieldType, fieldDefaults *defaults.FieldDef
 	case *defaults.FieldDefaults_Enum:
 		m.MustType(typ, pgs.EnumT, pgs.UnknownWKT)
 		m.CheckEnum(typ, r.Enum)
+		// The numeric values of singular fields are assigned as untyped constants,
+		// only the elements values are converted to the enum type.
+		if _, ok := typ.(pgs.FieldTypeElem); ok {
+			m.addEnumImport(typ)
+		}
+	case *defaults.FieldDefaults_EnumName:
+		m.MustType(typ, pgs.EnumT, pgs.UnknownWKT)
+		m.CheckEnumName(typ, r.EnumName)
+		m.addEnumImport(typ)
+	case *defaults.FieldDefaults_Any:
+		m.CheckAny(typ, r.Any)
+	case *defaults.FieldDefaults_Json:
+		m.CheckJSON(typ, r.Json)
+	case *defaults.FieldDefaults_Generate:
+		m.CheckGenerate(typ, r.Generate)
+	case *defaults.FieldDefaults_Env:
+		m.CheckEnv(typ, r.Env)
+	case *defaults.FieldDefaults_Expr:
+		m.CheckExpr(typ, r.Expr)
+	case *defaults.FieldDefaults_FromField:
+		m.CheckFromField(typ, r.FromField)
 	case *defaults.FieldDefaults_Duration:
 		m.CheckDuration(typ, r.Duration)
 	case *defaults.FieldDefaults_Timestamp:
 		m.CheckTimestamp(typ, r.Timestamp)
 	case *defaults.FieldDefaults_Message:
+		if typ, ok := typ.(pgs.FieldType); ok && (typ.IsRepeated() || typ.IsMap()) {
+			m.MustType(typ.Element(), pgs.MessageT, pgs.UnknownWKT)
+			m.CheckLiteral(typ.Field(), typ.Element().Embed(), r.Message)
+			break
+		}
 		m.MustType(typ, pgs.MessageT, pgs.UnknownWKT)
+		if f := fieldOf(typ); f != nil {
+			m.CheckLiteral(f, typ.Embed(), r.Message)
+		}
+	case *defaults.FieldDefaults_Repeated:
+		m.CheckRepeated(typ, r.Repeated)
+	case *defaults.FieldDefaults_Map:
+		m.CheckMap(typ, r.Map)
 	case nil: // noop
 	default:
 		m.Failf("unknown rule type (%T)", fieldDefaults.Type)
@@ -173,28 +217,223 @@ func (m *Module) CheckEnum(ft FieldType, r uint32) {
 	m.Failf("unexpected enum value %d for %s", r, typ.Enum().Name())
 }
 
+func (m *Module) CheckEnumName(ft FieldType, r string) pgs.EnumValue {
+	typ, ok := ft.(interface {
+		Enum() pgs.Enum
+	})
+
+	if !ok {
+		m.Failf("unexpected field type (%T)", ft)
+	}
+
+	if v := enumValue(typ.Enum(), r); v != nil {
+		return v
+	}
+	m.Failf("unexpected enum value %s for %s", r, typ.Enum().Name())
+	return nil
+}
+
+// addEnumImport registers the import of the enum type of ft
+// if it is declared in another package.
+func (m *Module) addEnumImport(ft FieldType) {
+	f := fieldOf(ft)
+	if f == nil {
+		return
+	}
+	if typ, ok := ft.(interface{ Enum() pgs.Enum }); ok && typ.Enum() != nil {
+		m.addImport(f, typ.Enum())
+	}
+}
+
+// fieldOf returns the field holding the type ft, or nil if ft is not
+// a field or a field element type.
+func fieldOf(ft FieldType) pgs.Field {
+	switch typ := ft.(type) {
+	case pgs.FieldType:
+		return typ.Field()
+	case pgs.FieldTypeElem:
+		return typ.ParentType().Field()
+	}
+	return nil
+}
+
 func (m *Module) CheckMessage(f pgs.Field, defaults *defaults.FieldDefaults) {
-	m.Assert(f.Type().IsEmbed(), "field is not embedded but got message defaults")
 	emb := f.Type().Embed()
-	if emb != nil && emb.IsWellKnown() {
-		switch emb.WellKnownType() {
-		case pgs.AnyWKT:
-			m.Failf("Any value should be used for Any fields")
-		case pgs.DurationWKT:
-			m.Failf("Duration value should be used for Duration fields")
-		case pgs.TimestampWKT:
-			m.Failf("Timestamp value should be used for Timestamp fields")
-		}
+	if f.Type().IsRepeated() || f.Type().IsMap() {
+		emb = f.Type().Element().Embed()
 	}
+	m.Assert(emb != nil, "field is not embedded but got message defaults")
+	m.checkEmbed(emb)
 	if !defaults.GetMessage().GetInitialize() {
 		return
 	}
-	current := m.ctx.ImportPath(f.Message()).String()
-	if i := m.ctx.ImportPath(f.Type().Embed()).String(); i != current {
-		m.imports[i] = struct{}{}
+	m.addImport(f, emb)
+}
+
+// CheckLiteral validates the literal of md against the message typ
+// and registers the imports needed by its go expression.
+func (m *Module) CheckLiteral(f pgs.Field, typ pgs.Message, md *defaults.MessageDefaults) {
+	if md.GetLiteral() == nil {
+		return
+	}
+	msg, err := m.types.messageValue(typ.FullyQualifiedName(), md)
+	m.CheckErr(err, "invalid message value")
+	m.literal(f, typ, msg)
+}
+
+func (m *Module) CheckRepeated(ft FieldType, r *defaults.RepeatedDefaults) {
+	typ := m.mustFieldType(ft)
+	m.Assert(typ.IsRepeated(), "repeated default should only be used for repeated fields")
+
+	el := typ.Element()
+	for i, v := range r.GetItems() {
+		m.Push(fmt.Sprintf("item[%d]", i))
+		switch v.Type.(type) {
+		case *defaults.FieldDefaults_Repeated:
+			m.Failf("repeated default cannot be used as a repeated item")
+		case nil:
+			m.Failf("empty repeated item")
+		}
+		if v.GetMessage() != nil {
+			m.checkEmbed(el.Embed())
+			m.addImport(typ.Field(), el.Embed())
+		}
+		m.CheckFieldRules(el, v)
+		m.Pop()
+	}
+}
+
+func (m *Module) CheckMap(ft FieldType, r *defaults.MapDefaults) {
+	typ := m.mustFieldType(ft)
+	m.Assert(typ.IsMap(), "map default should only be used for map fields")
+
+	keys := make(map[string]struct{})
+	for i, e := range r.GetEntries() {
+		m.Push(fmt.Sprintf("entry[%d]", i))
+		m.Assert(e.GetKey().GetType() != nil, "missing map entry key")
+		m.Assert(e.GetValue().GetType() != nil, "missing map entry value")
+		k := e.GetKey().String()
+		if _, ok := keys[k]; ok {
+			m.Failf("duplicate map entry key: %s", k)
+		}
+		keys[k] = struct{}{}
+		m.CheckFieldRules(typ.Key(), e.GetKey())
+		switch e.GetValue().GetType().(type) {
+		case *defaults.FieldDefaults_Repeated, *defaults.FieldDefaults_Map:
+			m.Failf("repeated and map defaults cannot be used as map values")
+		case *defaults.FieldDefaults_Message:
+			m.checkEmbed(typ.Element().Embed())
+			m.addImport(typ.Field(), typ.Element().Embed())
+		}
+		m.CheckFieldRules(typ.Element(), e.GetValue())
+		m.Pop()
+	}
+	if v := r.GetValues(); v != nil {
+		m.Assert(typ.Element().IsEmbed(), "values defaults should only be used for maps with message values")
+		m.checkEmbed(typ.Element().Embed())
+		m.CheckLiteral(typ.Field(), typ.Element().Embed(), v)
+		if v.GetInitialize() {
+			m.addImport(typ.Field(), typ.Element().Embed())
+		}
+	}
+}
+
+func (m *Module) checkEmbed(emb pgs.Message) {
+	if emb == nil || !emb.IsWellKnown() {
+		return
+	}
+	switch emb.WellKnownType() {
+	case pgs.AnyWKT:
+		m.Failf("Any value should be used for Any fields")
+	case pgs.DurationWKT:
+		m.Failf("Duration value should be used for Duration fields")
+	case pgs.TimestampWKT:
+		m.Failf("Timestamp value should be used for Timestamp fields")
+	}
+}
+
+func (m *Module) CheckAny(ft FieldType, r *defaults.AnyDefaults) {
+	embed := ft.Embed()
+	if embed == nil || embed.WellKnownType() != pgs.AnyWKT {
+		m.Failf("unexpected field type (%T) for Any, expected google.protobuf.Any ", ft)
+	}
+	m.resolveAny(r)
+	if f := fieldOf(ft); f != nil {
+		m.addImport(f, embed)
+	}
+}
+
+func (m *Module) CheckJSON(ft FieldType, r string) {
+	embed := ft.Embed()
+	if embed == nil {
+		m.Failf("unexpected field type (%T) for JSON, expected google.protobuf.Struct, google.protobuf.Value or google.protobuf.ListValue", ft)
+	}
+	m.resolveJSON(embed.WellKnownType(), r)
+	if f := fieldOf(ft); f != nil {
+		m.addImport(f, embed)
 	}
 }
 
+func (m *Module) CheckGenerate(ft FieldType, r *defaults.GenerateDefaults) {
+	typ, ok := ft.(pgs.FieldType)
+	if !ok {
+		m.Failf("generate cannot be used for repeated items or map entries")
+	}
+	if emb := typ.Embed(); emb != nil && emb.IsWellKnown() && (emb.WellKnownType() == pgs.StringValueWKT || emb.WellKnownType() == pgs.BytesValueWKT) {
+		m.Assert(!typ.IsRepeated(), "repeated default should be used for repeated fields")
+	} else {
+		m.Assert(!typ.IsRepeated() && (typ.ProtoType() == pgs.StringT || typ.ProtoType() == pgs.BytesT),
+			"generate should only be used for string and bytes fields")
+	}
+	switch r.GetType() {
+	case defaults.Generator_GENERATOR_UNSPECIFIED:
+		m.Failf("missing generator type")
+	case defaults.Generator_RANDOM_HEX:
+	default:
+		m.Assert(r.Length == nil, "length should only be used with RANDOM_HEX")
+	}
+	m.addImportPath(typ.Field(), defaultsImport)
+}
+
+func (m *Module) CheckEnv(ft FieldType, spec string) {
+	typ, ok := ft.(pgs.FieldType)
+	if !ok {
+		m.Failf("env cannot be used for repeated items or map entries")
+	}
+	m.Assert(!typ.IsRepeated() && !typ.IsMap(), "env cannot be used for repeated and map fields")
+	if emb := typ.Embed(); emb != nil {
+		switch emb.WellKnownType() {
+		case pgs.DurationWKT, pgs.TimestampWKT,
+			pgs.DoubleValueWKT, pgs.FloatValueWKT, pgs.Int64ValueWKT, pgs.UInt64ValueWKT, pgs.Int32ValueWKT,
+			pgs.UInt32ValueWKT, pgs.BoolValueWKT, pgs.StringValueWKT, pgs.BytesValueWKT:
+		default:
+			m.Failf("env cannot be used for %s fields", emb.FullyQualifiedName())
+		}
+	}
+	parts := strings.SplitN(spec, ":-", 2)
+	m.Assert(strings.TrimSpace(parts[0]) != "", "missing environment variable name")
+	if len(parts) == 2 {
+		_, err := defaults.ParseValue(m.fieldDescriptor(typ.Field()), parts[1])
+		m.CheckErr(err, "invalid env fallback")
+	}
+	if typ.Enum() != nil {
+		m.addImport(typ.Field(), typ.Enum())
+	}
+	m.addImportPath(typ.Field(), defaultsImport)
+}
+
+// CheckExpr fails if the expression r does not compile against the message of the field
+// or if its result cannot be set to the field.
+func (m *Module) CheckExpr(ft FieldType, r string) {
+	typ, ok := ft.(pgs.FieldType)
+	if !ok {
+		m.Failf("expr cannot be used for repeated items or map entries")
+	}
+	m.CheckErr(expr.Check(m.fieldDescriptor(typ.Field()), r), "invalid expr")
+	m.addImportPath(typ.Field(), defaultsImport)
+	m.addImportPath(typ.Field(), exprImport)
+}
+
 func (m *Module) CheckDuration(ft FieldType, r string) {
 	if embed := ft.Embed(); embed == nil || embed.WellKnownType() != pgs.DurationWKT {
 		m.Failf("unexpected field type (%T) for Duration, expected google.protobuf.Duration ", ft)
@@ -211,8 +450,16 @@ func (m *Module) CheckTimestamp(ft FieldType, r string) {
 	if strings.ToLower(v) == "now" {
 		return
 	}
-	_, err := parseTime(r)
-	m.Assert(err == nil, r, ": ", err)
+	_, err := parseTime(v)
+	if err == nil {
+		return
+	}
+	_, rerr := defaults.RelativeTime(v, time.Now())
+	m.Assert(rerr == nil, r, ": ", err, ", ", rerr)
+	if f := fieldOf(ft); f != nil {
+		m.addImportPath(f, "time")
+		m.addImportPath(f, defaultsImport)
+	}
 }
 
 func (m *Module) mustFieldType(ft FieldType) pgs.FieldType {
@@ -0,0 +1,244 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"github.com/prometheus/common/model"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// constant is the generated declaration holding the default value of a field.
+type constant struct {
+	name string
+	// isConst is set for the constants, the other values being declared as variables.
+	isConst bool
+	typ     string
+	value   string
+	// use is the go expression of a new field value built from the declaration.
+	use string
+	// inline is the go expression of a new field value built without the declaration.
+	inline string
+	// imports are the import paths used by the declaration, inlineImports the ones used by inline.
+	imports, inlineImports []string
+}
+
+// fieldConstant returns the declaration holding the default value described by fd
+// for the singular field f, if the value is constant.
+func (m *Module) fieldConstant(f pgs.Field, fd *defaults.FieldDefaults) (constant, bool) {
+	if f.Type().IsRepeated() || f.Type().IsMap() {
+		return constant{}, false
+	}
+	c := constant{name: fmt.Sprint(m.ctx.Name(f.Message()), `_Default_`, m.ctx.Name(f)), isConst: true}
+	c.use = c.name
+	c.typ = m.ctx.Type(f).Value().String()
+	if emb := f.Type().Embed(); emb != nil {
+		c.typ = wrapperType(emb.WellKnownType())
+	}
+	switch r := fd.GetType().(type) {
+	case *defaults.FieldDefaults_Float:
+		c.value = fmt.Sprint(r.Float)
+	case *defaults.FieldDefaults_Double:
+		c.value = fmt.Sprint(r.Double)
+	case *defaults.FieldDefaults_Int32:
+		c.value = fmt.Sprint(r.Int32)
+	case *defaults.FieldDefaults_Int64:
+		c.value = fmt.Sprint(r.Int64)
+	case *defaults.FieldDefaults_Uint32:
+		c.value = fmt.Sprint(r.Uint32)
+	case *defaults.FieldDefaults_Uint64:
+		c.value = fmt.Sprint(r.Uint64)
+	case *defaults.FieldDefaults_Sint32:
+		c.value = fmt.Sprint(r.Sint32)
+	case *defaults.FieldDefaults_Sint64:
+		c.value = fmt.Sprint(r.Sint64)
+	case *defaults.FieldDefaults_Fixed32:
+		c.value = fmt.Sprint(r.Fixed32)
+	case *defaults.FieldDefaults_Fixed64:
+		c.value = fmt.Sprint(r.Fixed64)
+	case *defaults.FieldDefaults_Sfixed32:
+		c.value = fmt.Sprint(r.Sfixed32)
+	case *defaults.FieldDefaults_Sfixed64:
+		c.value = fmt.Sprint(r.Sfixed64)
+	case *defaults.FieldDefaults_Bool:
+		c.value = fmt.Sprint(r.Bool)
+	case *defaults.FieldDefaults_String_:
+		c.value = strconv.Quote(r.String_)
+	case *defaults.FieldDefaults_Enum:
+		c.value = fmt.Sprint(r.Enum)
+		c.imports = []string{m.ctx.ImportPath(f.Type().Enum()).String()}
+		c.inlineImports = c.imports
+	case *defaults.FieldDefaults_EnumName:
+		c.value = m.enumValueName(f, f.Type().Enum(), r.EnumName)
+		c.imports = []string{m.ctx.ImportPath(f.Type().Enum()).String()}
+		c.inlineImports = c.imports
+	case *defaults.FieldDefaults_Bytes:
+		c.isConst, c.typ = false, ""
+		c.value = fmt.Sprint(`[]byte(`, strconv.Quote(string(r.Bytes)), `)`)
+		c.use = fmt.Sprint(`append([]byte(nil), `, c.name, `...)`)
+	case *defaults.FieldDefaults_Duration:
+		d, err := model.ParseDuration(r.Duration)
+		if err != nil {
+			m.Failf("invalid duration: %s %v", r.Duration, err)
+		}
+		c.typ = "time.Duration"
+		c.value = fmt.Sprint(int64(d))
+		c.use = fmt.Sprint(`durationpb.New(`, c.name, `)`)
+		c.inline = fmt.Sprint(`durationpb.New(`, c.value, `)`)
+		c.imports = []string{"time"}
+	case *defaults.FieldDefaults_Timestamp:
+		t, err := parseTime(strings.TrimSpace(r.Timestamp))
+		if err != nil {
+			return constant{}, false
+		}
+		c.isConst, c.typ = false, ""
+		c.value = fmt.Sprint(`time.Unix(`, t.Unix(), `, `, t.Nanosecond(), `).UTC()`)
+		c.use = fmt.Sprint(`timestamppb.New(`, c.name, `)`)
+		c.inline, _ = m.timestampValue(r.Timestamp)
+		c.imports = []string{"time"}
+	case *defaults.FieldDefaults_Any:
+		c.isConst, c.typ = false, ""
+		c.value = m.anyValue(m.ctx.Type(f), r.Any)
+		c.use = fmt.Sprint(`proto.Clone(`, c.name, `).(`, m.ctx.Type(f), `)`)
+		c.imports = []string{protoImport}
+	case *defaults.FieldDefaults_Json:
+		c.isConst, c.typ = false, ""
+		c.value = m.structValue(f, f.Type().Embed(), r.Json)
+		c.use = fmt.Sprint(`proto.Clone(`, c.name, `).(`, m.ctx.Type(f), `)`)
+		c.imports = []string{protoImport}
+	default:
+		return constant{}, false
+	}
+	if c.inline == "" {
+		c.inline = c.value
+	}
+	return c, true
+}
+
+// genConstants returns the declarations of the default values of the fields of msg.
+func (m *Module) genConstants(msg pgs.Message) string {
+	if !m.declared(msg) {
+		return ""
+	}
+	var consts, vars string
+	for _, f := range msg.Fields() {
+		fd, ok := m.fieldDefaults(f)
+		if !ok {
+			continue
+		}
+		c, ok := m.fieldConstant(f, fd)
+		if !ok {
+			continue
+		}
+		if c.isConst {
+			consts += fmt.Sprint("\n\t", c.name, ` `, c.typ, ` = `, c.value)
+		} else {
+			vars += fmt.Sprint("\n\t", c.name, ` = `, c.value)
+		}
+	}
+	var out string
+	if consts != "" {
+		out += fmt.Sprint("\nconst (", consts, "\n)\n")
+	}
+	if vars != "" {
+		out += fmt.Sprint("\nvar (", vars, "\n)\n")
+	}
+	return out
+}
+
+// addConstantsImports registers the imports used by the declarations of the default values of msg.
+func (m *Module) addConstantsImports(msg pgs.Message) {
+	if !m.declared(msg) {
+		return
+	}
+	for _, f := range msg.Fields() {
+		fd, ok := m.fieldDefaults(f)
+		if !ok {
+			continue
+		}
+		c, _ := m.fieldConstant(f, fd)
+		for _, v := range c.imports {
+			m.addImportPath(f, v)
+		}
+	}
+}
+
+// inlined returns the constant c used without its declaration, e.g. for the values of the conditional
+// defaults which are not declared.
+func (c constant) inlined() constant {
+	c.use, c.imports = c.inline, c.inlineImports
+	return c
+}
+
+// defaultConstant returns the declaration holding the default value described by fd for the field f,
+// inlined if the default values of its message are not declared.
+func (m *Module) defaultConstant(f pgs.Field, fd *defaults.FieldDefaults) constant {
+	c, _ := m.fieldConstant(f, fd)
+	if !m.declared(f.Message()) {
+		return c.inlined()
+	}
+	return c
+}
+
+// declared reports whether the default values of the fields of msg are declared, i.e. if the constants
+// plugin parameter is set and the methods of msg are generated, apply its defaults and are exported.
+func (m *Module) declared(msg pgs.Message) bool {
+	var unexported bool
+	if _, err := msg.Extension(defaults.E_Unexported, &unexported); err != nil || unexported {
+		return false
+	}
+	return m.constants && hasDefaults(msg)
+}
+
+// hasDefaults reports whether the methods of msg are generated and apply its defaults.
+func hasDefaults(msg pgs.Message) bool {
+	var ignored, disabled bool
+	if _, err := msg.Extension(defaults.E_Ignored, &ignored); err != nil || ignored {
+		return false
+	}
+	if _, err := msg.Extension(defaults.E_Disabled, &disabled); err != nil || disabled {
+		return false
+	}
+	return true
+}
+
+// wrapperType returns the go type of the value of the wrapper wk, or an empty string
+// if wk is not a wrapper.
+func wrapperType(wk pgs.WellKnownType) string {
+	switch wk {
+	case pgs.DoubleValueWKT:
+		return "float64"
+	case pgs.FloatValueWKT:
+		return "float32"
+	case pgs.Int64ValueWKT:
+		return "int64"
+	case pgs.UInt64ValueWKT:
+		return "uint64"
+	case pgs.Int32ValueWKT:
+		return "int32"
+	case pgs.UInt32ValueWKT:
+		return "uint32"
+	case pgs.BoolValueWKT:
+		return "bool"
+	case pgs.StringValueWKT:
+		return "string"
+	}
+	return ""
+}
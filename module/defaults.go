@@ -17,11 +17,15 @@ package module
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
 	"github.com/prometheus/common/model"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
 
 	"go.linka.cloud/protoc-gen-defaults/defaults"
 )
@@ -29,9 +33,16 @@ import (
 func (m *Module) genFieldDefaults(f pgs.Field, genOneOfField ...bool) (string, bool) {
 	m.Push(f.Name().String())
 	defer m.Pop()
-	var fieldDefaults defaults.FieldDefaults
-	ok, err := f.Extension(defaults.E_Value, &fieldDefaults)
-	if err != nil || !ok {
+	fieldDefaults := &defaults.FieldDefaults{}
+	ok, err := f.Extension(defaults.E_Value, fieldDefaults)
+	if err != nil {
+		return "", false
+	}
+	if native := m.proto2Rules(f); fieldDefaults.Type == nil && native != nil {
+		fieldDefaults, ok = native, true
+	}
+	when := m.fieldWhen(f)
+	if !ok && len(when) == 0 {
 		return "", false
 	}
 	wk := pgs.UnknownWKT
@@ -73,82 +84,115 @@ func (m *Module) genFieldDefaults(f pgs.Field, genOneOfField ...bool) (string, b
 		out += `}`
 		return out, true
 	}
+	if len(when) != 0 {
+		return m.whenDefaults(f, fieldDefaults, when), true
+	}
+	return m.rulesDefaults(f, fieldDefaults, m.defaultConstant(f, fieldDefaults), wk)
+}
+
+// rulesDefaults returns the statements applying the defaults rules fieldDefaults to the field f,
+// using the declaration c of the default value.
+func (m *Module) rulesDefaults(f pgs.Field, fieldDefaults *defaults.FieldDefaults, c constant, wk pgs.WellKnownType) (string, bool) {
 	name := m.ctx.Name(f)
 	switch r := fieldDefaults.Type.(type) {
 	case *defaults.FieldDefaults_Float:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetFloat(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Double:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetDouble(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Int32:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetInt32(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Int64:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetInt64(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Uint32:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetUint32(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Uint64:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetUint64(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Sint32:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetSint32(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Sint64:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetSint64(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Fixed32:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetFixed32(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Fixed64:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetFixed32(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Sfixed32:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetSfixed32(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Sfixed64:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetSfixed64(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
 	case *defaults.FieldDefaults_Bool:
-		return m.simpleDefaults(f, false, fieldDefaults.GetBool(), wk), true
+		return m.simpleDefaults(f, false, c.use, wk), true
 	case *defaults.FieldDefaults_String_:
-		return m.simpleDefaults(f, `""`, fmt.Sprint(`"`, fieldDefaults.GetString_(), `"`), wk), true
+		return m.simpleDefaults(f, `""`, c.use, wk), true
 	case *defaults.FieldDefaults_Bytes:
 		if wk == pgs.UnknownWKT {
+			check := fmt.Sprint(`len(x.`, name, `) == 0`)
+			if m.isNilable(f) {
+				check = fmt.Sprint(`x.`, name, ` == nil`)
+			}
 			return fmt.Sprint(`
-				if len(x.`, name, `) == 0 {
-					x.`, name, ` = []byte("`, string(fieldDefaults.GetBytes()), `")
+				if `, check, ` {
+				x.`, name, ` = `, c.use, m.reported(pathExpr(f.Name().String(), "")), `
 				}`), true
 		}
 		return fmt.Sprint(`
 				if x.`, name, ` == nil {
-					x.`, name, ` = &wrapperspb.BytesValue{Value: []byte("`, string(fieldDefaults.GetBytes()), `")}
+					x.`, name, ` = &wrapperspb.BytesValue{Value: `, c.use, `}`, m.reported(pathExpr(f.Name().String(), "")), `
 				}`), true
 	case *defaults.FieldDefaults_Enum:
-		return m.simpleDefaults(f, 0, fieldDefaults.GetEnum(), wk), true
+		return m.simpleDefaults(f, 0, c.use, wk), true
+	case *defaults.FieldDefaults_EnumName:
+		return m.simpleDefaults(f, 0, c.use, wk), true
+	case *defaults.FieldDefaults_Any:
+		return m.simpleDefaults(f, `nil`, c.use, pgs.UnknownWKT), true
+	case *defaults.FieldDefaults_Json:
+		return m.simpleDefaults(f, `nil`, c.use, pgs.UnknownWKT), true
+	case *defaults.FieldDefaults_Generate:
+		return m.generateDefaults(f, r.Generate, wk), true
+	case *defaults.FieldDefaults_Env:
+		return m.envDefaults(f, r.Env), true
+	case *defaults.FieldDefaults_Expr:
+		return m.exprDefaults(f, r.Expr), true
+	case *defaults.FieldDefaults_FromField:
+		return m.fromFieldDefaults(f, r.FromField), true
 	case *defaults.FieldDefaults_Duration:
-		d, err := model.ParseDuration(fieldDefaults.GetDuration())
-		if err != nil {
-			m.Failf("invalid duration: %s %v", fieldDefaults.GetDuration(), err)
-		}
-		return m.simpleDefaults(f, `nil`, fmt.Sprint(`durationpb.New(`, int64(d), `)`), pgs.UnknownWKT), true
+		return m.simpleDefaults(f, `nil`, c.use, pgs.UnknownWKT), true
 	case *defaults.FieldDefaults_Timestamp:
-		v := strings.TrimSpace(fieldDefaults.GetTimestamp())
-		if strings.ToLower(v) == "now" {
-			return m.simpleDefaults(f, `nil`, `timestamppb.Now()`, pgs.UnknownWKT), true
+		v, relative := m.timestampValue(fieldDefaults.GetTimestamp())
+		if c.use != "" {
+			v = c.use
 		}
-		t, err := parseTime(v)
-		if err != nil {
-			m.Failf("invalid timestamp: %s %v", fieldDefaults.GetTimestamp(), err)
+		if relative {
+			return fmt.Sprint(`
+					if x.`, name, ` == nil {
+						if t, err := defaults.RelativeTime(`, strconv.Quote(fieldDefaults.GetTimestamp()), `, time.Now()); err != nil {
+							`, m.onError(f.Name().String()), `
+						} else {
+							x.`, name, ` = timestamppb.New(t)`, m.reported(pathExpr(f.Name().String(), "")), `
+						}
+					}`), true
 		}
-		v = fmt.Sprint(`&timestamppb.Timestamp{Seconds: `, t.Unix(), `, Nanos: `, t.Nanosecond(), `}
-			`)
 		return m.simpleDefaults(f, `nil`, v, pgs.UnknownWKT), true
 	case *defaults.FieldDefaults_Message:
-		if fieldDefaults.GetMessage() != nil && fieldDefaults.GetMessage().Defaults != nil && !fieldDefaults.GetMessage().GetDefaults() {
-			return fmt.Sprint("\n// ", name, ": defaults disabled by [(defaults.value).message = {defaults: false}]"), true
+		if f.Type().IsRepeated() || f.Type().IsMap() {
+			return m.elemsDefaults(f, r.Message), true
 		}
 		var decl string
-		if fieldDefaults.GetMessage().GetInitialize() {
+		if msg := m.messageLiteral(f.Type().Embed(), r.Message); msg != nil {
+			decl = m.mergeMessage(f, "x."+name.String(), pathExpr(f.Name().String(), ""), f.Type().Embed(), msg)
+		} else if r.Message.GetInitialize() {
 			decl = fmt.Sprint(`
 				if x.`, name, ` == nil {
-					x.`, name, ` = &`, m.ctx.Type(f).Value(), `{}
+					x.`, name, ` = &`, m.ctx.Type(f).Value(), `{}`, m.reported(pathExpr(f.Name().String(), "")), `
 				}`)
 		}
-		return decl + fmt.Sprint(`
-			if v, ok := interface{}(x.`, name, `).(interface{Default()}); ok && x.`, name, ` != nil {
-				v.Default()
-			}`), true
+		if r.Message != nil && r.Message.Defaults != nil && !r.Message.GetDefaults() {
+			return decl + fmt.Sprint("\n// ", name, ": defaults disabled by [(defaults.value).message = {defaults: false}]"), true
+		}
+		return decl + m.callDefault("x."+name.String(), f.Name().String(), ""), true
+	case *defaults.FieldDefaults_Repeated:
+		return m.repeatedDefaults(f, r.Repeated), true
+	case *defaults.FieldDefaults_Map:
+		return m.mapDefaults(f, r.Map), true
 	case nil: // noop
 	default:
 		_ = r
@@ -159,26 +203,448 @@ func (m *Module) genFieldDefaults(f pgs.Field, genOneOfField ...bool) (string, b
 
 func (m *Module) simpleDefaults(f pgs.Field, zero, value interface{}, wk pgs.WellKnownType) string {
 	name := m.ctx.Name(f).String()
+	report := m.reported(pathExpr(f.Name().String(), ""))
 	if wk != "" && wk != pgs.UnknownWKT {
 		return fmt.Sprint(`
 			if x.`, name, ` == nil {
-				x.`, name, ` = &wrapperspb.`, wk, `{Value: `, value, `}
+				x.`, name, ` = &wrapperspb.`, wk, `{Value: `, value, `}`, report, `
 			}`)
 	}
-	if f.HasOptionalKeyword() {
+	if m.isPointer(f) {
 		zero = "nil"
 		return fmt.Sprint(`
 		if x.`, name, ` == `, zero, ` {
 			v := `, m.ctx.Type(f).Value(), `(`, value, `)
-			x.`, name, ` = &v 
+			x.`, name, ` = &v`, report, `
 		}`)
 	}
 	return fmt.Sprint(`
 		if x.`, name, ` == `, zero, ` {
-			x.`, name, ` = `, value, `
+			x.`, name, ` = `, value, report, `
+		}`)
+}
+
+// generateDefaults returns the statements setting the unset string or bytes field f
+// to a value produced by the generator r. The field is left unset if the generation fails.
+func (m *Module) generateDefaults(f pgs.Field, r *defaults.GenerateDefaults, wk pgs.WellKnownType) string {
+	name := m.ctx.Name(f).String()
+	fn := "Generate"
+	if f.Type().ProtoType() == pgs.BytesT || wk == pgs.BytesValueWKT {
+		fn = "GenerateBytes"
+	}
+	value := "v"
+	check := fmt.Sprint(`x.`, name, ` == ""`)
+	switch {
+	case wk != pgs.UnknownWKT:
+		value = fmt.Sprint(`&wrapperspb.`, wk, `{Value: v}`)
+		check = fmt.Sprint(`x.`, name, ` == nil`)
+	case m.isNilable(f):
+		check = fmt.Sprint(`x.`, name, ` == nil`)
+	case fn == "GenerateBytes":
+		check = fmt.Sprint(`len(x.`, name, `) == 0`)
+	case m.isPointer(f):
+		value = "&v"
+		check = fmt.Sprint(`x.`, name, ` == nil`)
+	}
+	return fmt.Sprint(`
+		if `, check, ` {
+				if v, err := defaults.`, fn, `(defaults.Generator_`, r.GetType(), `, `, r.GetLength(), `); err != nil {
+					`, m.onError(f.Name().String()), `
+				} else {
+					x.`, name, ` = `, value, m.reported(pathExpr(f.Name().String(), "")), `
+				}
+			}`)
+}
+
+// envDefaults returns the statements setting the unset field f to the value of the environment
+// variable described by spec. Parse errors are reported with onError.
+func (m *Module) envDefaults(f pgs.Field, spec string) string {
+	check, value := m.reflectedValue(f)
+	return fmt.Sprint(`
+		if `, check, ` {
+				if v, ok, err := defaults.EnvValue((*`, m.ctx.Name(f.Message()), `)(nil).ProtoReflect().Descriptor().Fields().ByNumber(`, f.Descriptor().GetNumber(), `), `, strconv.Quote(spec), `); err != nil {
+					`, m.onError(f.Name().String()), `
+				} else if ok {
+					x.`, m.ctx.Name(f), ` = `, value, m.reported(pathExpr(f.Name().String(), "")), `
+				}
+		}`)
+}
+
+// exprDefaults returns the statements setting the result of the expression r to the field f if it is unset.
+func (m *Module) exprDefaults(f pgs.Field, r string) string {
+	check, value := m.reflectedValue(f)
+	return fmt.Sprint(`
+		if `, check, ` {
+				if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(`, f.Descriptor().GetNumber(), `), `, strconv.Quote(r), `); err != nil {
+					`, m.onError(f.Name().String()), `
+				} else if ok {
+					x.`, m.ctx.Name(f), ` = `, value, m.reported(pathExpr(f.Name().String(), "")), `
+				}
+		}`)
+}
+
+// reflectedValue returns the go expressions reporting whether the field f is unset
+// and converting the protoreflect value v to a value of the field.
+func (m *Module) reflectedValue(f pgs.Field) (check, value string) {
+	name := m.ctx.Name(f).String()
+	typ := m.ctx.Type(f)
+	switch f.Type().ProtoType() {
+	case pgs.MessageT:
+		value = fmt.Sprint(`v.Message().Interface().(`, typ, `)`)
+	case pgs.EnumT:
+		value = fmt.Sprint(typ.Value(), `(v.Enum())`)
+	case pgs.Int32T, pgs.SInt32, pgs.SFixed32:
+		value = `int32(v.Int())`
+	case pgs.Int64T, pgs.SInt64, pgs.SFixed64:
+		value = `v.Int()`
+	case pgs.UInt32T, pgs.Fixed32T:
+		value = `uint32(v.Uint())`
+	case pgs.UInt64T, pgs.Fixed64T:
+		value = `v.Uint()`
+	case pgs.FloatT:
+		value = `float32(v.Float())`
+	case pgs.DoubleT:
+		value = `v.Float()`
+	case pgs.BoolT:
+		value = `v.Bool()`
+	case pgs.StringT:
+		value = `v.String()`
+	case pgs.BytesT:
+		value = `v.Bytes()`
+	}
+	switch {
+	case f.Type().IsEmbed():
+		check = fmt.Sprint(`x.`, name, ` == nil`)
+	case m.isNilable(f):
+		check = fmt.Sprint(`x.`, name, ` == nil`)
+	case f.Type().ProtoType() == pgs.BytesT:
+		check = fmt.Sprint(`len(x.`, name, `) == 0`)
+	case m.isPointer(f):
+		check = fmt.Sprint(`x.`, name, ` == nil`)
+		value = fmt.Sprint(`func(v `, typ.Value(), `) `, typ, ` { return &v }(`, value, `)`)
+	default:
+		check = fmt.Sprint(`x.`, name, ` == `, zeroLiteral(f.Type()))
+	}
+	return check, value
+}
+
+func (m *Module) repeatedDefaults(f pgs.Field, r *defaults.RepeatedDefaults) string {
+	name := m.ctx.Name(f).String()
+	typ := m.ctx.Type(f)
+	var items []string
+	var calls string
+	for i, v := range r.GetItems() {
+		items = append(items, m.elemValue(f.Type().Element(), typ.Element(), v))
+		if v.GetMessage().GetDefaults() {
+			calls += m.callDefault(fmt.Sprint(`x.`, name, `[`, i, `]`), f.Name().String(), strconv.Itoa(i))
+		}
+	}
+	return fmt.Sprint(`
+		if len(x.`, name, `) == 0 {
+			x.`, name, ` = `, typ, `{`, strings.Join(items, ", "), `}`, m.reported(pathExpr(f.Name().String(), "")), calls, `
+		}`)
+}
+
+func (m *Module) mapDefaults(f pgs.Field, r *defaults.MapDefaults) string {
+	name := m.ctx.Name(f).String()
+	typ := m.ctx.Type(f)
+	var out string
+	if len(r.GetEntries()) != 0 {
+		var entries string
+		for _, e := range r.GetEntries() {
+			k := m.elemValue(f.Type().Key(), typ.Key(), e.GetKey())
+			v := m.elemValue(f.Type().Element(), typ.Element(), e.GetValue())
+			call := m.reported(pathExpr(f.Name().String(), k))
+			if e.GetValue().GetMessage().GetDefaults() {
+				call += m.callDefault(fmt.Sprint(`x.`, name, `[`, k, `]`), f.Name().String(), k)
+			}
+			if r.GetMerge() {
+				entries += fmt.Sprint(`
+					if _, ok := x.`, name, `[`, k, `]; !ok {
+						x.`, name, `[`, k, `] = `, v, call, `
+					}`)
+			} else {
+				entries += fmt.Sprint(`
+					x.`, name, `[`, k, `] = `, v, call)
+			}
+		}
+		if r.GetMerge() {
+			out += fmt.Sprint(`
+				if x.`, name, ` == nil {
+					x.`, name, ` = make(`, typ, `)
+				}`, entries)
+		} else {
+			out += fmt.Sprint(`
+				if len(x.`, name, `) == 0 {
+					x.`, name, ` = make(`, typ, `)`, entries, `
+				}`)
+		}
+	}
+	return out + m.elemsDefaults(f, r.GetValues())
+}
+
+// elemsDefaults returns the message defaults md applied to every element
+// of the repeated or map field f.
+func (m *Module) elemsDefaults(f pgs.Field, md *defaults.MessageDefaults) string {
+	if md == nil || !md.GetDefaults() && !md.GetInitialize() && md.GetLiteral() == nil {
+		return ""
+	}
+	name := m.ctx.Name(f).String()
+	var out string
+	if msg := m.messageLiteral(f.Type().Element().Embed(), md); msg != nil {
+		out += fmt.Sprint(`
+			for k, v := range x.`, name, ` {`, m.mergeMessage(f, "v", pathExpr(f.Name().String(), "k"), f.Type().Element().Embed(), msg), `
+				x.`, name, `[k] = v`)
+	} else if md.GetInitialize() {
+		out += fmt.Sprint(`
+			for k, v := range x.`, name, ` {
+				if v == nil {
+					v = &`, m.ctx.Type(f).Element().Value(), `{}
+					x.`, name, `[k] = v`, m.reported(pathExpr(f.Name().String(), "k")), `
+				}`)
+	} else {
+		out += fmt.Sprint(`
+			for `, m.elemsKey(), `, v := range x.`, name, ` {
+				if v == nil {
+					continue
+				}`)
+	}
+	if md.GetDefaults() {
+		out += m.callDefault("v", f.Name().String(), "k")
+	}
+	return out + `
+		}`
+}
+
+// elemsKey returns the loop key of elemsDefaults, only used to report errors and paths.
+func (m *Module) elemsKey() string {
+	if m.mode != modeDefault {
+		return "k"
+	}
+	return "_"
+}
+
+// callDefault returns the statements applying the defaults of the message expr.
+// The DefaultE and DefaultReport methods are preferred when rendering them, the errors
+// or paths being reported for the field path, or path[key] if key is not empty.
+func (m *Module) callDefault(expr, path, key string) string {
+	var call string
+	switch m.mode {
+	case modeErrors:
+		call = fmt.Sprint(`errs.Add(`, strconv.Quote(path), `, v.DefaultE())`)
+		if key != "" {
+			call = fmt.Sprint(`errs.AddKey(`, strconv.Quote(path), `, `, key, `, v.DefaultE())`)
+		}
+		call = fmt.Sprint(`
+			if v, ok := interface{}(`, expr, `).(interface{DefaultE() error}); ok && `, expr, ` != nil {
+				`, call, `
+			} else`)
+	case modeReport:
+		call = fmt.Sprint(`
+			if v, ok := interface{}(`, expr, `).(interface{DefaultReport() []defaults.FieldPath}); ok && `, expr, ` != nil {
+				paths = append(paths, defaults.PrefixPaths(`, pathExpr(path, key), `, v.DefaultReport())...)
+			} else`)
+	}
+	return fmt.Sprint(call, `
+		if v, ok := interface{}(`, expr, `).(interface{Default()}); ok && `, expr, ` != nil {
+			v.Default()
 		}`)
 }
 
+// reported returns the statement collecting the path when rendering DefaultReport.
+// The path is a go expression as returned by pathExpr.
+func (m *Module) reported(path string) string {
+	if m.mode != modeReport {
+		return ""
+	}
+	return fmt.Sprint(`
+		paths = append(paths, `, path, `)`)
+}
+
+// pathExpr returns the go expression of the path of the field path,
+// or of its element path[key] if key is not empty.
+func pathExpr(path, key string) string {
+	if key == "" {
+		return strconv.Quote(path)
+	}
+	return fmt.Sprint(`defaults.KeyPath(`, strconv.Quote(path), `, `, key, `)`)
+}
+
+// subPathExpr returns the go expression of the path of the field name of the message at path.
+func subPathExpr(path, name string) string {
+	if p, err := strconv.Unquote(path); err == nil {
+		return strconv.Quote(p + "." + name)
+	}
+	return fmt.Sprint(path, ` + ".`, name, `"`)
+}
+
+// onError returns the statement reporting the error err of the field path:
+// it is collected when rendering DefaultE, and passed to defaults.ErrorHandler otherwise.
+func (m *Module) onError(path string) string {
+	if m.mode == modeErrors {
+		return fmt.Sprint(`errs.Add(`, strconv.Quote(path), `, err)`)
+	}
+	return fmt.Sprint(`defaults.ErrorHandler(&defaults.FieldError{Path: `, strconv.Quote(path), `, Err: err})`)
+}
+
+// elemValue returns the go expression of the value described by fd for
+// the element el of Go type typ.
+func (m *Module) elemValue(el pgs.FieldTypeElem, typ pgsgo.TypeName, fd *defaults.FieldDefaults) string {
+	return m.fieldValue(el.ParentType().Field(), el, typ, fd)
+}
+
+// fieldValue returns the go expression of the value described by fd for a value
+// of type el and Go type typ of the field f.
+func (m *Module) fieldValue(f pgs.Field, el literalType, typ pgsgo.TypeName, fd *defaults.FieldDefaults) string {
+	wk := pgs.UnknownWKT
+	if emb := el.Embed(); emb != nil {
+		wk = emb.WellKnownType()
+	}
+	var v interface{}
+	switch r := fd.Type.(type) {
+	case *defaults.FieldDefaults_Float:
+		v = r.Float
+	case *defaults.FieldDefaults_Double:
+		v = r.Double
+	case *defaults.FieldDefaults_Int32:
+		v = r.Int32
+	case *defaults.FieldDefaults_Int64:
+		v = r.Int64
+	case *defaults.FieldDefaults_Uint32:
+		v = r.Uint32
+	case *defaults.FieldDefaults_Uint64:
+		v = r.Uint64
+	case *defaults.FieldDefaults_Sint32:
+		v = r.Sint32
+	case *defaults.FieldDefaults_Sint64:
+		v = r.Sint64
+	case *defaults.FieldDefaults_Fixed32:
+		v = r.Fixed32
+	case *defaults.FieldDefaults_Fixed64:
+		v = r.Fixed64
+	case *defaults.FieldDefaults_Sfixed32:
+		v = r.Sfixed32
+	case *defaults.FieldDefaults_Sfixed64:
+		v = r.Sfixed64
+	case *defaults.FieldDefaults_Bool:
+		v = r.Bool
+	case *defaults.FieldDefaults_String_:
+		v = strconv.Quote(r.String_)
+	case *defaults.FieldDefaults_Bytes:
+		v = fmt.Sprint(`[]byte(`, strconv.Quote(string(r.Bytes)), `)`)
+	case *defaults.FieldDefaults_Enum:
+		return fmt.Sprint(typ, `(`, r.Enum, `)`)
+	case *defaults.FieldDefaults_EnumName:
+		return m.enumValueName(f, el.Enum(), r.EnumName)
+	case *defaults.FieldDefaults_Any:
+		return m.anyValue(typ, r.Any)
+	case *defaults.FieldDefaults_Json:
+		return m.structValue(f, el.Embed(), r.Json)
+	case *defaults.FieldDefaults_Duration:
+		d, err := model.ParseDuration(r.Duration)
+		if err != nil {
+			m.Failf("invalid duration: %s %v", r.Duration, err)
+		}
+		return fmt.Sprint(`durationpb.New(`, int64(d), `)`)
+	case *defaults.FieldDefaults_Timestamp:
+		v, relative := m.timestampValue(r.Timestamp)
+		if relative {
+			return fmt.Sprint(`func() *timestamppb.Timestamp {
+				t, err := defaults.RelativeTime(`, strconv.Quote(r.Timestamp), `, time.Now())
+				if err != nil {
+					`, m.onError(f.Name().String()), `
+					return nil
+				}
+				return timestamppb.New(t)
+			}()`)
+		}
+		return v
+	case *defaults.FieldDefaults_Message:
+		if msg := m.messageLiteral(el.Embed(), r.Message); msg != nil {
+			return m.literal(f, el.Embed(), msg)
+		}
+		return fmt.Sprint(`&`, typ.Value(), `{}`)
+	default:
+		m.Failf("unsupported rule type (%T) for repeated item", fd.Type)
+	}
+	if wk != pgs.UnknownWKT {
+		return fmt.Sprint(`&wrapperspb.`, wk, `{Value: `, v, `}`)
+	}
+	return fmt.Sprint(v)
+}
+
+// timestampValue returns the go expression of the timestamp r, or reports
+// that r is a relative timestamp which must be computed at call time.
+func (m *Module) timestampValue(r string) (string, bool) {
+	v := strings.TrimSpace(r)
+	if strings.ToLower(v) == "now" {
+		return `timestamppb.Now()`, false
+	}
+	t, err := parseTime(v)
+	if err == nil {
+		return fmt.Sprint(`&timestamppb.Timestamp{Seconds: `, t.Unix(), `, Nanos: `, t.Nanosecond(), `}`), false
+	}
+	if _, rerr := defaults.RelativeTime(v, time.Now()); rerr != nil {
+		m.Failf("invalid timestamp: %s %v, %v", r, err, rerr)
+	}
+	return "", true
+}
+
+// resolveAny returns the google.protobuf.Any described by r, failing if it cannot be resolved.
+func (m *Module) resolveAny(r *defaults.AnyDefaults) *anypb.Any {
+	v, err := m.types.anyValue(r)
+	m.CheckErr(err, "invalid any value")
+	return v
+}
+
+// anyValue returns the go expression of the pre-marshalled Any of type typ described by r.
+func (m *Module) anyValue(typ pgsgo.TypeName, r *defaults.AnyDefaults) string {
+	v := m.resolveAny(r)
+	return fmt.Sprint(`&`, typ.Value(), `{TypeUrl: `, strconv.Quote(v.TypeUrl), `, Value: []byte(`, strconv.Quote(string(v.Value)), `)}`)
+}
+
+// enumValueName returns the go constant name of the enum value r
+// as used from the file holding the field f.
+func (m *Module) enumValueName(f pgs.Field, e pgs.Enum, r string) string {
+	v := enumValue(e, r)
+	if v == nil {
+		m.Failf("unexpected enum value %s for %s", r, e.Name())
+	}
+	return m.qualifiedName(f, e, m.ctx.Name(v).String())
+}
+
+// messageLiteral returns the message of type typ described by the literal of md,
+// or nil if md has no literal.
+func (m *Module) messageLiteral(typ pgs.Message, md *defaults.MessageDefaults) protoreflect.Message {
+	if md.GetLiteral() == nil {
+		return nil
+	}
+	msg, err := m.types.messageValue(typ.FullyQualifiedName(), md)
+	if err != nil {
+		m.Failf("invalid message value: %v", err)
+	}
+	return msg
+}
+
+// enumValue returns the value of e matching either the name or the fully
+// qualified name r, or nil if no value matches. As in protobuf scoping rules,
+// the fully qualified name may omit the enum name, e.g. pkg.Message.VALUE.
+func enumValue(e pgs.Enum, r string) pgs.EnumValue {
+	r = strings.TrimPrefix(strings.TrimSpace(r), ".")
+	fqn := strings.TrimPrefix(e.FullyQualifiedName(), ".")
+	var scope string
+	if i := strings.LastIndex(fqn, "."); i >= 0 {
+		scope = fqn[:i+1]
+	}
+	for _, v := range e.Values() {
+		name := v.Name().String()
+		if r == name || r == fqn+"."+name || r == scope+name {
+			return v
+		}
+	}
+	return nil
+}
+
 func parseTime(s string) (time.Time, error) {
 	for _, format := range []string{
 		time.RFC822,
@@ -0,0 +1,210 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// genIsDefault returns the statements of the IsDefault method of msg, comparing its fields
+// to their constant default values, as defaults.Diff does. The fields whose defaults are
+// not constant are not compared.
+func (m *Module) genIsDefault(msg pgs.Message) string {
+	var checks string
+	for _, f := range msg.Fields() {
+		checks += m.genFieldDiff(f)
+	}
+	return checks
+}
+
+// genFieldDiff returns the statements returning false if the field f is set to another value
+// than its default.
+func (m *Module) genFieldDiff(f pgs.Field) string {
+	m.Push(f.Name().String())
+	defer m.Pop()
+	fd, ok := m.fieldDefaults(f)
+	if when := m.fieldWhen(f); len(when) != 0 {
+		return m.whenDiff(f, fd, when, "x."+m.ctx.Name(f).String())
+	}
+	if !ok {
+		return ""
+	}
+	if !f.InRealOneOf() {
+		expr := "x." + m.ctx.Name(f).String()
+		return m.differs(f, fd, expr) + m.diffMessages(f, fd, expr)
+	}
+	if m.isOneOfDone(f.OneOf()) {
+		return ""
+	}
+	m.setOneOfDone(f.OneOf())
+	var oneOfDefault string
+	if _, err := f.OneOf().Extension(defaults.E_Oneof, &oneOfDefault); err != nil {
+		m.Fail(err)
+	}
+	var cases string
+	for _, f := range f.OneOf().Fields() {
+		fd, ok := m.fieldDefaults(f)
+		if !ok {
+			continue
+		}
+		expr := "o." + m.ctx.Name(f).String()
+		var s string
+		if diffValue(f, fd) && f.Name().String() != oneOfDefault {
+			s = `
+				return false`
+		} else {
+			s = m.differs(f, fd, expr) + m.diffMessages(f, fd, expr)
+		}
+		if s != "" {
+			cases += fmt.Sprint(`
+				case *`, m.ctx.OneofOption(f), `:`, s)
+		}
+	}
+	if cases == "" {
+		return ""
+	}
+	return fmt.Sprint(`
+		switch o := x.`, m.ctx.Name(f.OneOf()), `.(type) {`, cases, `
+		}`)
+}
+
+// differs returns the statements returning false if the field f of go expression expr
+// is set to another value than the constant one described by fd.
+func (m *Module) differs(f pgs.Field, fd *defaults.FieldDefaults, expr string) string {
+	if !diffValue(f, fd) || !defaults.IsConstant(fd) {
+		return ""
+	}
+	set := f.InRealOneOf()
+	wk := pgs.UnknownWKT
+	if emb := f.Type().Embed(); emb != nil {
+		wk = emb.WellKnownType()
+	}
+	var cond string
+	switch r := fd.Type.(type) {
+	case *defaults.FieldDefaults_Message:
+		msg := m.messageLiteral(f.Type().Embed(), r.Message)
+		if msg == nil {
+			if r.Message.Defaults != nil && !r.Message.GetDefaults() {
+				cond = fmt.Sprint(expr, ` != nil && proto.Size(`, expr, `) != 0`)
+			}
+			break
+		}
+		cond = fmt.Sprint(expr, ` != nil && !proto.Equal(`, expr, `, `, m.literalValue(f, r.Message, msg), `)`)
+	case *defaults.FieldDefaults_Repeated:
+		items := []string{fmt.Sprint(`len(`, expr, `) != `, len(r.Repeated.GetItems()))}
+		for i, v := range r.Repeated.GetItems() {
+			items = append(items, fmt.Sprint(`!(`, m.stripEqual(f.Type().Element(), m.ctx.Type(f).Element(), v, fmt.Sprint(expr, `[`, i, `]`), v.GetMessage().GetDefaults()), `)`))
+		}
+		cond = fmt.Sprint(`len(`, expr, `) != 0 && (`, strings.Join(items, " || "), `)`)
+	case *defaults.FieldDefaults_Map:
+		typ := m.ctx.Type(f)
+		var checks string
+		for _, e := range r.Map.GetEntries() {
+			eq := m.stripEqual(f.Type().Element(), typ.Element(), e.GetValue(), "v", e.GetValue().GetMessage().GetDefaults() || r.Map.GetValues().GetDefaults())
+			checks += fmt.Sprint(`
+				if v, ok := `, expr, `[`, m.elemValue(f.Type().Key(), typ.Key(), e.GetKey()), `]; !ok || !(`, eq, `) {
+					return false
+				}`)
+		}
+		return fmt.Sprint(`
+			if len(`, expr, `) != 0 {
+				if len(`, expr, `) != `, len(r.Map.GetEntries()), ` {
+					return false
+				}`, checks, `
+			}`)
+	case *defaults.FieldDefaults_Bytes:
+		switch {
+		case wk != pgs.UnknownWKT:
+			cond = fmt.Sprint(expr, ` != nil && string(`, expr, `.Value) != `, strconv.Quote(string(r.Bytes)))
+		case set:
+			cond = fmt.Sprint(`string(`, expr, `) != `, strconv.Quote(string(r.Bytes)))
+		case m.isNilable(f):
+			cond = fmt.Sprint(expr, ` != nil && string(`, expr, `) != `, strconv.Quote(string(r.Bytes)))
+		default:
+			cond = fmt.Sprint(`len(`, expr, `) != 0 && string(`, expr, `) != `, strconv.Quote(string(r.Bytes)))
+		}
+	default:
+		v := m.fieldValue(f, f.Type(), m.ctx.Type(f).Value(), fd)
+		switch {
+		case f.Type().IsEmbed():
+			cond = fmt.Sprint(expr, ` != nil && !proto.Equal(`, expr, `, `, v, `)`)
+		case m.isPointer(f):
+			cond = fmt.Sprint(expr, ` != nil && *`, expr, ` != `, v)
+		case set:
+			cond = fmt.Sprint(expr, ` != `, v)
+		default:
+			cond = fmt.Sprint(expr, ` != `, zeroLiteral(f.Type()), ` && `, expr, ` != `, v)
+		}
+	}
+	if cond == "" {
+		return ""
+	}
+	return fmt.Sprint(`
+		if `, cond, ` {
+			return false
+		}`)
+}
+
+// diffMessages returns the statements returning false if the messages held by the field f
+// of go expression expr are not default, when their defaults are applied.
+func (m *Module) diffMessages(f pgs.Field, fd *defaults.FieldDefaults, expr string) string {
+	md := fd.GetMessage()
+	if f.Type().IsMap() && fd.GetMap() != nil {
+		md = fd.GetMap().GetValues()
+	}
+	switch {
+	case md == nil:
+		return ""
+	case f.Type().IsRepeated() || f.Type().IsMap():
+		if !md.GetDefaults() || md.GetLiteral() != nil {
+			return ""
+		}
+		return fmt.Sprint(`
+			for _, v := range `, expr, ` {`, callIsDefault("v"), `
+			}`)
+	case f.Type().IsEmbed():
+		if md.GetLiteral() != nil || md.Defaults != nil && !md.GetDefaults() {
+			return ""
+		}
+		return callIsDefault(expr)
+	}
+	return ""
+}
+
+// callIsDefault returns the statements returning false if the message expr is not default.
+func callIsDefault(expr string) string {
+	return fmt.Sprint(`
+		if d, ok := interface{}(`, expr, `).(interface{ IsDefault() bool }); ok && `, expr, ` != nil && !d.IsDefault() {
+			return false
+		}`)
+}
+
+// diffValue reports whether the rules fd describe a value for the field f itself,
+// and not only the defaults of the messages it holds.
+func diffValue(f pgs.Field, fd *defaults.FieldDefaults) bool {
+	switch r := fd.GetType().(type) {
+	case *defaults.FieldDefaults_Message:
+		return !f.Type().IsRepeated() && !f.Type().IsMap() && (r.Message.GetLiteral() != nil || r.Message.GetInitialize())
+	case *defaults.FieldDefaults_Map:
+		return len(r.Map.GetEntries()) != 0
+	}
+	return true
+}
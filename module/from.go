@@ -0,0 +1,88 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// CheckFromField fails if the path does not lead to a field of the same type as the field.
+func (m *Module) CheckFromField(ft FieldType, path string) {
+	typ, ok := ft.(pgs.FieldType)
+	if !ok {
+		m.Failf("from_field cannot be used for repeated items or map entries")
+	}
+	m.fromFieldPath(typ.Field(), path)
+	if typ.IsEmbed() {
+		m.addImportPath(typ.Field(), protoImport)
+	}
+}
+
+// fromFieldPath returns the fields leading from the message of the field f to the field its value is copied from.
+func (m *Module) fromFieldPath(f pgs.Field, path string) []pgs.Field {
+	m.CheckErr(defaults.CheckFromField(m.fieldDescriptor(f), path), "invalid from_field")
+	msg := f.Message()
+	var fields []pgs.Field
+	for _, name := range strings.Split(strings.TrimSpace(path), ".") {
+		for _, v := range msg.Fields() {
+			if v.Name().String() == name {
+				fields = append(fields, v)
+				break
+			}
+		}
+		if emb := fields[len(fields)-1].Type().Embed(); emb != nil {
+			msg = emb
+		}
+	}
+	return fields
+}
+
+// fromFieldDefaults returns the statements copying the field at path to the field f if it is unset.
+func (m *Module) fromFieldDefaults(f pgs.Field, path string) string {
+	fields := m.fromFieldPath(f, path)
+	src := fields[len(fields)-1]
+	cond := m.presence("x", src, true)
+	expr := "x"
+	if len(fields) > 1 {
+		getters := "x"
+		for _, v := range fields[:len(fields)-1] {
+			getters += fmt.Sprint(`.Get`, m.ctx.Name(v), `()`)
+		}
+		cond = fmt.Sprint(`p := `, getters, `; p != nil && `, m.presence("p", src, true))
+		expr = "p"
+	}
+	value := fmt.Sprint(expr, `.Get`, m.ctx.Name(src), `()`)
+	switch {
+	case f.Type().IsEmbed():
+		value = fmt.Sprint(`proto.Clone(`, value, `).(`, m.ctx.Type(f), `)`)
+	case f.Type().ProtoType() == pgs.BytesT:
+		value = fmt.Sprint(`append([]byte(nil), `, value, `...)`)
+	case m.isPointer(f):
+		typ := m.ctx.Type(f)
+		value = fmt.Sprint(`func(v `, typ.Value(), `) `, typ, ` { return &v }(`, value, `)`)
+	}
+	check, _ := m.reflectedValue(f)
+	return fmt.Sprint(`
+		if `, check, ` {
+			if `, cond, ` {
+				x.`, m.ctx.Name(f), ` = `, value, m.reported(pathExpr(f.Name().String(), "")), `
+			}
+		}`)
+}
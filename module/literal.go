@@ -0,0 +1,244 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// literalType is implemented by both pgs.FieldType and pgs.FieldTypeElem.
+type literalType interface {
+	ProtoType() pgs.ProtoType
+	Embed() pgs.Message
+	Enum() pgs.Enum
+}
+
+var goScalars = map[pgs.ProtoType]string{
+	pgs.DoubleT:  "float64",
+	pgs.FloatT:   "float32",
+	pgs.Int64T:   "int64",
+	pgs.SInt64:   "int64",
+	pgs.SFixed64: "int64",
+	pgs.UInt64T:  "uint64",
+	pgs.Fixed64T: "uint64",
+	pgs.Int32T:   "int32",
+	pgs.SInt32:   "int32",
+	pgs.SFixed32: "int32",
+	pgs.UInt32T:  "uint32",
+	pgs.Fixed32T: "uint32",
+	pgs.BoolT:    "bool",
+	pgs.StringT:  "string",
+	pgs.BytesT:   "[]byte",
+}
+
+// mergeMessage returns the statements setting the go expression name of message type typ
+// to the literal msg if it is nil, or merging msg into its unset fields otherwise.
+// Type names are qualified as used from the file holding the field f, path is the go expression of its path.
+func (m *Module) mergeMessage(f pgs.Field, name, path string, typ pgs.Message, msg protoreflect.Message) string {
+	out := fmt.Sprint(`
+		if `, name, ` == nil {
+			`, name, ` = `, m.literal(f, typ, msg), m.reported(path), `
+		}`)
+	if merge := m.mergeFields(f, name, path, typ, msg); merge != "" {
+		out += ` else {` + merge + `
+		}`
+	}
+	return out
+}
+
+// mergeFields returns the statements setting the fields of msg which are unset
+// in the go expression name of message type typ.
+func (m *Module) mergeFields(f pgs.Field, name, path string, typ pgs.Message, msg protoreflect.Message) string {
+	var out string
+	for _, pf := range typ.Fields() {
+		fd := msg.Descriptor().Fields().ByNumber(protoreflect.FieldNumber(pf.Descriptor().GetNumber()))
+		if fd == nil || !msg.Has(fd) {
+			continue
+		}
+		field := name + "." + m.ctx.Name(pf).String()
+		report := m.reported(subPathExpr(path, pf.Name().String()))
+		switch {
+		case pf.InRealOneOf():
+			oneOf := name + "." + m.ctx.Name(pf.OneOf()).String()
+			out += fmt.Sprint(`
+				if `, oneOf, ` == nil {
+					`, oneOf, ` = `, m.oneOfLiteral(f, pf, msg.Get(fd)), report, `
+				}`)
+		case pf.Type().IsEmbed():
+			out += m.mergeMessage(f, field, subPathExpr(path, pf.Name().String()), pf.Type().Embed(), msg.Get(fd).Message())
+		case m.isPointer(pf) || m.isNilable(pf):
+			out += fmt.Sprint(`
+				if `, field, ` == nil {
+					`, field, ` = `, m.fieldLiteral(f, pf, msg.Get(fd)), report, `
+				}`)
+		case pf.Type().IsRepeated() || pf.Type().IsMap() || pf.Type().ProtoType() == pgs.BytesT:
+			out += fmt.Sprint(`
+				if len(`, field, `) == 0 {
+					`, field, ` = `, m.fieldLiteral(f, pf, msg.Get(fd)), report, `
+				}`)
+		default:
+			out += fmt.Sprint(`
+				if `, field, ` == `, zeroLiteral(pf.Type()), ` {
+					`, field, ` = `, m.fieldLiteral(f, pf, msg.Get(fd)), report, `
+				}`)
+		}
+	}
+	return out
+}
+
+// literal returns the go expression of the message msg of type typ.
+func (m *Module) literal(f pgs.Field, typ pgs.Message, msg protoreflect.Message) string {
+	var fields []string
+	for _, pf := range typ.Fields() {
+		fd := msg.Descriptor().Fields().ByNumber(protoreflect.FieldNumber(pf.Descriptor().GetNumber()))
+		if fd == nil || !msg.Has(fd) {
+			continue
+		}
+		if pf.InRealOneOf() {
+			fields = append(fields, fmt.Sprint(m.ctx.Name(pf.OneOf()), `: `, m.oneOfLiteral(f, pf, msg.Get(fd))))
+			continue
+		}
+		fields = append(fields, fmt.Sprint(m.ctx.Name(pf), `: `, m.fieldLiteral(f, pf, msg.Get(fd))))
+	}
+	return fmt.Sprint(`&`, m.qualifiedName(f, typ, m.ctx.Name(typ).String()), `{`, strings.Join(fields, ", "), `}`)
+}
+
+func (m *Module) oneOfLiteral(f pgs.Field, pf pgs.Field, v protoreflect.Value) string {
+	return fmt.Sprint(`&`, m.qualifiedName(f, pf.Message(), m.ctx.OneofOption(pf).String()), `{`, m.ctx.Name(pf), `: `, m.valueLiteral(f, pf.Type(), v), `}`)
+}
+
+func (m *Module) fieldLiteral(f pgs.Field, pf pgs.Field, v protoreflect.Value) string {
+	typ := pf.Type()
+	switch {
+	case typ.IsMap():
+		type entry struct{ k, v string }
+		var entries []entry
+		v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			entries = append(entries, entry{k: m.valueLiteral(f, typ.Key(), k.Value()), v: m.valueLiteral(f, typ.Element(), v)})
+			return true
+		})
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].k < entries[j].k
+		})
+		var values []string
+		for _, e := range entries {
+			values = append(values, e.k+": "+e.v)
+		}
+		return fmt.Sprint(`map[`, m.goType(f, typ.Key()), `]`, m.goType(f, typ.Element()), `{`, strings.Join(values, ", "), `}`)
+	case typ.IsRepeated():
+		var values []string
+		for i := 0; i < v.List().Len(); i++ {
+			values = append(values, m.valueLiteral(f, typ.Element(), v.List().Get(i)))
+		}
+		return fmt.Sprint(`[]`, m.goType(f, typ.Element()), `{`, strings.Join(values, ", "), `}`)
+	case m.isPointer(pf):
+		t := m.goType(f, typ)
+		return fmt.Sprint(`func(v `, t, `) *`, t, ` { return &v }(`, m.valueLiteral(f, typ, v), `)`)
+	}
+	return m.valueLiteral(f, typ, v)
+}
+
+func (m *Module) valueLiteral(f pgs.Field, typ literalType, v protoreflect.Value) string {
+	switch {
+	case typ.Embed() != nil:
+		return m.literal(f, typ.Embed(), v.Message())
+	case typ.Enum() != nil:
+		for _, ev := range typ.Enum().Values() {
+			if ev.Value() == int32(v.Enum()) {
+				return m.qualifiedName(f, typ.Enum(), m.ctx.Name(ev).String())
+			}
+		}
+		return fmt.Sprint(m.goType(f, typ), `(`, int32(v.Enum()), `)`)
+	}
+	switch typ.ProtoType() {
+	case pgs.StringT:
+		return strconv.Quote(v.String())
+	case pgs.BytesT:
+		return fmt.Sprint(`[]byte(`, strconv.Quote(string(v.Bytes())), `)`)
+	case pgs.DoubleT, pgs.FloatT:
+		if math.IsInf(v.Float(), 0) || math.IsNaN(v.Float()) {
+			m.Failf("unsupported float value: %v", v.Float())
+		}
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64)
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+// goType returns the go type name of typ.
+func (m *Module) goType(f pgs.Field, typ literalType) string {
+	switch {
+	case typ.Embed() != nil:
+		return "*" + m.qualifiedName(f, typ.Embed(), m.ctx.Name(typ.Embed()).String())
+	case typ.Enum() != nil:
+		return m.qualifiedName(f, typ.Enum(), m.ctx.Name(typ.Enum()).String())
+	}
+	return goScalars[typ.ProtoType()]
+}
+
+// qualifiedName returns the go name of the entity e qualified with its package name
+// if it is declared in another package than the file holding the field f.
+func (m *Module) qualifiedName(f pgs.Field, e pgs.Entity, name string) string {
+	if m.ctx.ImportPath(e) == m.ctx.ImportPath(f.Message()) {
+		return name
+	}
+	m.addImport(f, e)
+	return m.ctx.PackageName(e).String() + "." + name
+}
+
+// isPointer reports whether the scalar field f is generated as a pointer, which is the case
+// of the fields tracking their presence: proto3 optional, proto2 and editions explicit or legacy required fields.
+func (m *Module) isPointer(f pgs.Field) bool {
+	if f.Type().IsRepeated() || f.Type().IsMap() || f.Type().IsEmbed() || f.InRealOneOf() || f.Type().ProtoType() == pgs.BytesT {
+		return false
+	}
+	return m.fieldDescriptor(f).HasPresence()
+}
+
+// isNilable reports whether the bytes field f tracks its presence, its unset value being nil and not
+// only empty: proto3 optional, proto2 and editions explicit or legacy required fields.
+func (m *Module) isNilable(f pgs.Field) bool {
+	if f.Type().ProtoType() != pgs.BytesT || f.Type().IsRepeated() || f.Type().IsMap() || f.InRealOneOf() {
+		return false
+	}
+	return m.fieldDescriptor(f).HasPresence()
+}
+
+// fieldDescriptor returns the descriptor of the field f, its features being resolved.
+func (m *Module) fieldDescriptor(f pgs.Field) protoreflect.FieldDescriptor {
+	d, err := m.types.files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(f.FullyQualifiedName(), ".")))
+	m.CheckErr(err, "unable to find field descriptor")
+	fd, ok := d.(protoreflect.FieldDescriptor)
+	if !ok {
+		m.Failf("unexpected field descriptor type (%T)", d)
+	}
+	return fd
+}
+
+func zeroLiteral(typ pgs.FieldType) string {
+	switch typ.ProtoType() {
+	case pgs.StringT:
+		return `""`
+	case pgs.BoolT:
+		return `false`
+	}
+	return `0`
+}
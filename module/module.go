@@ -25,10 +25,16 @@ import (
 	"go.linka.cloud/protoc-gen-defaults/defaults"
 )
 
+// defaultsImport is the import path of the defaults runtime package.
+const defaultsImport = "go.linka.cloud/protoc-gen-defaults/defaults"
+
+// exprImport is the import path of the package evaluating the expr rules.
+const exprImport = "go.linka.cloud/protoc-gen-defaults/defaults/expr"
+
 func Defaults() *Module {
 	return &Module{
 		ModuleBase: &pgs.ModuleBase{},
-		imports:    make(map[string]struct{}),
+		imports:    make(map[string]map[string]struct{}),
 		oneOfs:     make(map[string]struct{}),
 	}
 }
@@ -37,10 +43,42 @@ type Module struct {
 	*pgs.ModuleBase
 	ctx     pgsgo.Context
 	tpl     *template.Template
-	imports map[string]struct{}
+	imports map[string]map[string]struct{}
 	oneOfs  map[string]struct{}
+	types   *types
+	// errors, report, strip and diff enable the generation of the DefaultE, DefaultReport,
+	// StripDefaults and IsDefault methods.
+	errors bool
+	report bool
+	strip  bool
+	diff   bool
+	// proto2 uses the native proto2 default values as rules, unless overridden by the proto2_defaults file option.
+	proto2 bool
+	// constructors enables the generation of the New<Message> constructors,
+	// unless overridden by the constructor message option.
+	constructors bool
+	// constants enables the declaration of the constant default values of the fields.
+	constants bool
+	// mode is the method being rendered.
+	mode mode
 }
 
+// mode is the generated method being rendered.
+type mode int
+
+const (
+	// modeDefault renders Default.
+	modeDefault mode = iota
+	// modeErrors renders DefaultE, collecting the errors.
+	modeErrors
+	// modeReport renders DefaultReport, collecting the paths of the fields set.
+	modeReport
+	// modeStrip renders StripDefaults.
+	modeStrip
+	// modeDiff renders IsDefault.
+	modeDiff
+)
+
 func (m *Module) Name() string {
 	return "defaults"
 }
@@ -48,17 +86,42 @@ func (m *Module) Name() string {
 func (m *Module) InitContext(c pgs.BuildContext) {
 	m.ModuleBase.InitContext(c)
 	m.ctx = pgsgo.InitContext(c.Parameters())
+	errs, err := c.Parameters().BoolDefault("errors", false)
+	m.CheckErr(err, "invalid errors parameter")
+	m.errors = errs
+	report, err := c.Parameters().BoolDefault("report", false)
+	m.CheckErr(err, "invalid report parameter")
+	m.report = report
+	strip, err := c.Parameters().BoolDefault("strip", false)
+	m.CheckErr(err, "invalid strip parameter")
+	m.strip = strip
+	diff, err := c.Parameters().BoolDefault("diff", false)
+	m.CheckErr(err, "invalid diff parameter")
+	m.diff = diff
+	constructors, err := c.Parameters().BoolDefault("constructors", false)
+	m.CheckErr(err, "invalid constructors parameter")
+	m.constructors = constructors
+	constants, err := c.Parameters().BoolDefault("constants", false)
+	m.CheckErr(err, "invalid constants parameter")
+	m.constants = constants
+	proto2, err := c.Parameters().BoolDefault("proto2_defaults", false)
+	m.CheckErr(err, "invalid proto2_defaults parameter")
+	m.proto2 = proto2
 
 	tpl := template.New("fields").Funcs(map[string]interface{}{
 		"package": m.ctx.PackageName,
 		"name":    m.ctx.Name,
 		"defaultMethod": func(m pgs.Message) string {
-			var private bool
-			m.Extension(defaults.E_Unexported, &private)
-			if private {
-				return "_Default"
-			}
-			return "Default"
+			return methodName(m, "Default")
+		},
+		"stripMethod": func(m pgs.Message) string {
+			return methodName(m, "StripDefaults")
+		},
+		"isDefaultMethod": func(m pgs.Message) string {
+			return methodName(m, "IsDefault")
+		},
+		"constructorName": func(msg pgs.Message) string {
+			return methodName(msg, "New"+m.ctx.Name(msg).String())
 		},
 		"comment": func(s string) string {
 			var out string
@@ -71,9 +134,9 @@ func (m *Module) InitContext(c pgs.BuildContext) {
 			}
 			return out
 		},
-		"imports": func() string {
+		"imports": func(f pgs.File) string {
 			var imports string
-			for v := range m.imports {
+			for v := range m.imports[f.Name().String()] {
 				imports += fmt.Sprintf("\"%s\"\n", v)
 			}
 			return imports
@@ -94,15 +157,60 @@ func (m *Module) InitContext(c pgs.BuildContext) {
 			}
 			return !disabled
 		},
+		"errors": func() bool {
+			return m.errors
+		},
+		"report": func() bool {
+			return m.report
+		},
+		"strip": func() bool {
+			return m.strip
+		},
+		"diff": func() bool {
+			return m.diff
+		},
+		"constructor": func(msg pgs.Message) bool {
+			var constructor bool
+			ok, err := msg.Extension(defaults.E_Constructor, &constructor)
+			if err != nil || !ok {
+				return m.constructors
+			}
+			return constructor
+		},
+		"fields": func(msg pgs.Message) []pgs.Field {
+			return m.orderedFields(msg)
+		},
+		"stripFields": func(msg pgs.Message) []pgs.Field {
+			return m.stripFields(msg)
+		},
 		"defaults": func(f pgs.Field) string {
-			v, _ := m.genFieldDefaults(f)
-			return v
+			return m.render(modeDefault, f)
+		},
+		"defaultsE": func(f pgs.Field) string {
+			return m.render(modeErrors, f)
+		},
+		"defaultsReport": func(f pgs.Field) string {
+			return m.render(modeReport, f)
+		},
+		"defaultsStrip": func(f pgs.Field) string {
+			m.mode = modeStrip
+			defer func() { m.mode = modeDefault }()
+			return m.genFieldStrip(f)
+		},
+		"constants": func(msg pgs.Message) string {
+			return m.genConstants(msg)
+		},
+		"isDefault": func(msg pgs.Message) string {
+			m.mode = modeDiff
+			defer func() { m.mode = modeDefault }()
+			return m.genIsDefault(msg)
 		},
 	})
 	m.tpl = template.Must(tpl.Parse(defaultsTpl))
 }
 
-func (m *Module) Execute(targets map[string]pgs.File, _ map[string]pgs.Package) []pgs.Artifact {
+func (m *Module) Execute(targets map[string]pgs.File, pkgs map[string]pgs.Package) []pgs.Artifact {
+	m.types = newTypes(pkgs)
 	for _, f := range targets {
 		m.generate(f)
 	}
@@ -113,15 +221,60 @@ func (m *Module) generate(f pgs.File) {
 	if len(f.Messages()) == 0 {
 		return
 	}
+	m.CheckErr(m.types.fileErr(f), "unable to load file descriptor")
 	for _, msg := range f.Messages() {
 		m.Check(msg)
 	}
+	for _, msg := range f.AllMessages() {
+		m.addConstantsImports(msg)
+	}
+	if m.errors || m.report {
+		m.addFileImport(f, defaultsImport)
+	}
+	if m.strip || m.diff {
+		m.addFileImport(f, protoImport)
+	}
 	name := m.ctx.OutputPath(f).SetExt(".defaults.go")
 	m.AddGeneratorTemplateFile(name.String(), m.tpl, f)
 }
 
+// methodName returns the name of the generated method, prefixed by an underscore
+// if the message is marked as unexported.
+func methodName(m pgs.Message, name string) string {
+	var private bool
+	m.Extension(defaults.E_Unexported, &private)
+	if private {
+		return "_" + name
+	}
+	return name
+}
+
+// addImport registers the import path of e if it differs from the one of
+// the message holding the field f.
+func (m *Module) addImport(f pgs.Field, e pgs.Entity) {
+	m.addImportPath(f, m.ctx.ImportPath(e).String())
+}
+
+// addImportPath registers the import path i if it differs from the one of
+// the message holding the field f.
+func (m *Module) addImportPath(f pgs.Field, i string) {
+	m.addFileImport(f.File(), i)
+}
+
+// addFileImport registers the import path i if it differs from the one of the file f.
+func (m *Module) addFileImport(f pgs.File, i string) {
+	if i == m.ctx.ImportPath(f).String() {
+		return
+	}
+	name := f.Name().String()
+	if _, ok := m.imports[name]; !ok {
+		m.imports[name] = make(map[string]struct{})
+	}
+	m.imports[name][i] = struct{}{}
+}
+
 func (m *Module) isOneOfDone(oneOf pgs.OneOf) bool {
-	_, done := m.oneOfs[oneOf.FullyQualifiedName()]
+	_, done := m.oneOfs[m.oneOfKey(oneOf)]
 	return done
 }
 
@@ -129,7 +282,20 @@ func (m *Module) setOneOfDone(oneOf pgs.OneOf) {
 	if oneOf == nil {
 		return
 	}
-	m.oneOfs[oneOf.FullyQualifiedName()] = struct{}{}
+	m.oneOfs[m.oneOfKey(oneOf)] = struct{}{}
+}
+
+// oneOfKey returns the key tracking the generation of oneOf in the current method.
+func (m *Module) oneOfKey(oneOf pgs.OneOf) string {
+	return fmt.Sprint(oneOf.FullyQualifiedName(), "#", m.mode)
+}
+
+// render returns the statements applying the defaults of the field f in the method of the given mode.
+func (m *Module) render(mode mode, f pgs.Field) string {
+	m.mode = mode
+	defer func() { m.mode = modeDefault }()
+	v, _ := m.genFieldDefaults(f)
+	return v
 }
 
 const defaultsTpl = `{{ comment .SyntaxSourceCodeInfo.LeadingComments }}
@@ -144,25 +310,91 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
-	{{ imports }}
+	{{ imports . }}
 )
 
 var (
 	_ *timestamppb.Timestamp
 	_ *durationpb.Duration
 	_ *wrapperspb.BoolValue
+	{{- if or strip diff }}
+	_ = proto.Equal
+	{{- end }}
 )
 
 {{ range .AllMessages }}
 
 {{ if gen . }}
+{{- if enabled . }}
+{{ constants . }}
+{{- end }}
 func (x *{{ name . }}) {{ defaultMethod . }}() {
 	{{- if enabled . }}
-		{{- range .Fields }}
+		{{- range fields . }}
 			{{- defaults . }}
 		{{- end }}
 	{{- end }} 
 }
+{{- if errors }}
+
+func (x *{{ name . }}) {{ defaultMethod . }}E() error {
+	var errs defaults.Errors
+	{{- if enabled . }}
+		{{- range fields . }}
+			{{- defaultsE . }}
+		{{- end }}
+	{{- end }}
+	return errs.Err()
+}
+{{- end }}
+{{- if report }}
+
+func (x *{{ name . }}) {{ defaultMethod . }}Report() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	{{- if enabled . }}
+		{{- range fields . }}
+			{{- defaultsReport . }}
+		{{- end }}
+	{{- end }}
+	return paths
+}
+{{- end }}
+{{- if strip }}
+
+func (x *{{ name . }}) {{ stripMethod . }}() {
+	{{- if enabled . }}
+		{{- range stripFields . }}
+			{{- defaultsStrip . }}
+		{{- end }}
+	{{- end }}
+}
+{{- end }}
+{{- if diff }}
+
+func (x *{{ name . }}) {{ isDefaultMethod . }}() bool {
+	{{- if enabled . }}
+		{{- isDefault . }}
+	{{- end }}
+	return true
+}
+{{- end }}
+{{- if constructor . }}
+
+func {{ constructorName . }}() *{{ name . }} {
+	x := &{{ name . }}{}
+	x.{{ defaultMethod . }}()
+	return x
+}
+
+func {{ constructorName . }}With(fn func(x *{{ name . }})) *{{ name . }} {
+	x := &{{ name . }}{}
+	if fn != nil {
+		fn(x)
+	}
+	x.{{ defaultMethod . }}()
+	return x
+}
+{{- end }}
 {{- end }}
 {{ end }}
 `
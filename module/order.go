@@ -0,0 +1,61 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// orderedFields returns the fields of msg in the order their defaults are applied, as defaults.FieldOrder
+// returns them, so that the generated methods apply them as the reflection does.
+func (m *Module) orderedFields(msg pgs.Message) []pgs.Field {
+	order, err := defaults.FieldOrder(m.messageDescriptor(msg))
+	m.CheckErr(err, "unable to order fields")
+	fields := make(map[protoreflect.FieldNumber]pgs.Field, len(msg.Fields()))
+	for _, f := range msg.Fields() {
+		fields[protoreflect.FieldNumber(f.Descriptor().GetNumber())] = f
+	}
+	out := make([]pgs.Field, 0, len(order))
+	for _, fd := range order {
+		out = append(out, fields[fd.Number()])
+	}
+	return out
+}
+
+// stripFields returns the fields of msg in the reverse order their defaults are applied, as defaults.Strip
+// walks them, so that the conditional defaults are resolved before the fields their conditions are evaluated on are stripped.
+func (m *Module) stripFields(msg pgs.Message) []pgs.Field {
+	fields := m.orderedFields(msg)
+	for i, j := 0, len(fields)-1; i < j; i, j = i+1, j-1 {
+		fields[i], fields[j] = fields[j], fields[i]
+	}
+	return fields
+}
+
+// messageDescriptor returns the descriptor of the message msg.
+func (m *Module) messageDescriptor(msg pgs.Message) protoreflect.MessageDescriptor {
+	d, err := m.types.files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(msg.FullyQualifiedName(), ".")))
+	m.CheckErr(err, "unable to find message descriptor")
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		m.Failf("unexpected message descriptor type (%T)", d)
+	}
+	return md
+}
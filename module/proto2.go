@@ -0,0 +1,97 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/proto"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// fieldDefaults returns the defaults rule of the field f, if any, falling back
+// to its native proto2 default value when enabled.
+func (m *Module) fieldDefaults(f pgs.Field) (*defaults.FieldDefaults, bool) {
+	fd := &defaults.FieldDefaults{}
+	ok, err := f.Extension(defaults.E_Value, fd)
+	if err != nil {
+		return nil, false
+	}
+	if ok && fd.Type != nil {
+		return fd, true
+	}
+	if native := m.proto2Rules(f); native != nil {
+		return native, true
+	}
+	return nil, false
+}
+
+// proto2Rules returns the rules setting the native default value of the proto2 or editions field f,
+// or nil if it has none or if the native defaults are not enabled for its file.
+func (m *Module) proto2Rules(f pgs.Field) *defaults.FieldDefaults {
+	if f.Descriptor().DefaultValue == nil || !m.proto2Enabled(f.File()) {
+		return nil
+	}
+	return defaults.Proto2Rules(m.fieldDescriptor(f))
+}
+
+// proto2Enabled reports whether the native proto2 default values of the file f are used as rules,
+// either with the (defaults.proto2_defaults) file option or the proto2_defaults plugin parameter.
+func (m *Module) proto2Enabled(f pgs.File) bool {
+	var enabled bool
+	ok, err := f.Extension(defaults.E_Proto2Defaults, &enabled)
+	m.CheckErr(err, "unable to read proto2_defaults extension from file")
+	if ok {
+		return enabled
+	}
+	return m.proto2
+}
+
+// CheckProto2 fails if the field f declares both a native proto2 default value
+// and defaults rules fd setting another value.
+func (m *Module) CheckProto2(f pgs.Field, fd *defaults.FieldDefaults) {
+	native := m.proto2Rules(f)
+	if native == nil || fd.GetType() == nil {
+		return
+	}
+	if !proto.Equal(m.enumRules(f, native), m.enumRules(f, fd)) {
+		m.Failf("(defaults.value) disagrees with the native default value: %s", f.Descriptor().GetDefaultValue())
+	}
+}
+
+// enumRules returns the enum rules fd of the field f using the enum value name,
+// so that they can be compared, or fd if they are not enum rules.
+func (m *Module) enumRules(f pgs.Field, fd *defaults.FieldDefaults) *defaults.FieldDefaults {
+	e := f.Type().Enum()
+	if e == nil {
+		return fd
+	}
+	var v pgs.EnumValue
+	switch r := fd.GetType().(type) {
+	case *defaults.FieldDefaults_Enum:
+		for _, ev := range e.Values() {
+			if ev.Value() == int32(r.Enum) {
+				v = ev
+				break
+			}
+		}
+	case *defaults.FieldDefaults_EnumName:
+		v = enumValue(e, r.EnumName)
+	}
+	if v == nil {
+		return fd
+	}
+	return &defaults.FieldDefaults{Type: &defaults.FieldDefaults_EnumName{EnumName: v.Name().String()}}
+}
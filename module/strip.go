@@ -0,0 +1,236 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	pgsgo "github.com/lyft/protoc-gen-star/lang/go"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// protoImport is the import path of the protobuf runtime package used by the StripDefaults and IsDefault methods.
+const protoImport = "google.golang.org/protobuf/proto"
+
+// genFieldStrip returns the statements of the StripDefaults method clearing the field f
+// if it holds its default value, as defaults.Strip does.
+func (m *Module) genFieldStrip(f pgs.Field) string {
+	m.Push(f.Name().String())
+	defer m.Pop()
+	fd, ok := m.fieldDefaults(f)
+	if when := m.fieldWhen(f); len(when) != 0 {
+		return m.whenStrip(f, fd, when, "x."+m.ctx.Name(f).String())
+	}
+	if !ok {
+		return ""
+	}
+	if !f.InRealOneOf() {
+		return m.stripValue(f, fd, "x."+m.ctx.Name(f).String(), true)
+	}
+	if m.isOneOfDone(f.OneOf()) {
+		return ""
+	}
+	m.setOneOfDone(f.OneOf())
+	var oneOfDefault string
+	if _, err := f.OneOf().Extension(defaults.E_Oneof, &oneOfDefault); err != nil {
+		m.Fail(err)
+	}
+	var cases string
+	for _, f := range f.OneOf().Fields() {
+		fd, ok := m.fieldDefaults(f)
+		if !ok {
+			continue
+		}
+		if s := m.stripValue(f, fd, "o."+m.ctx.Name(f).String(), f.Name().String() == oneOfDefault); s != "" {
+			cases += fmt.Sprint(`
+				case *`, m.ctx.OneofOption(f), `:`, s)
+		}
+	}
+	if cases == "" {
+		return ""
+	}
+	return fmt.Sprint(`
+		switch o := x.`, m.ctx.Name(f.OneOf()), `.(type) {`, cases, `
+		}`)
+}
+
+// stripValue returns the statements clearing the field f of go expression expr if it holds
+// the default value described by fd. If clear is false, the field is never cleared but
+// the messages it holds are still stripped.
+func (m *Module) stripValue(f pgs.Field, fd *defaults.FieldDefaults, expr string, clear bool) string {
+	if !defaults.IsConstant(fd) {
+		return ""
+	}
+	wk := pgs.UnknownWKT
+	if emb := f.Type().Embed(); emb != nil {
+		wk = emb.WellKnownType()
+	}
+	var pre, cond string
+	switch r := fd.Type.(type) {
+	case *defaults.FieldDefaults_Message:
+		if f.Type().IsRepeated() || f.Type().IsMap() {
+			return m.stripElems(expr, r.Message)
+		}
+		if msg := m.messageLiteral(f.Type().Embed(), r.Message); msg != nil {
+			cond = fmt.Sprint(expr, ` != nil && proto.Equal(`, expr, `, `, m.literalValue(f, r.Message, msg), `)`)
+			break
+		}
+		if r.Message.Defaults == nil || r.Message.GetDefaults() {
+			pre = m.callStrip(expr)
+		}
+		if r.Message.GetInitialize() {
+			cond = fmt.Sprint(expr, ` != nil && proto.Size(`, expr, `) == 0`)
+		}
+	case *defaults.FieldDefaults_Repeated:
+		items := []string{fmt.Sprint(`len(`, expr, `) == `, len(r.Repeated.GetItems()))}
+		for i, v := range r.Repeated.GetItems() {
+			items = append(items, m.stripEqual(f.Type().Element(), m.ctx.Type(f).Element(), v, fmt.Sprint(expr, `[`, i, `]`), v.GetMessage().GetDefaults()))
+		}
+		cond = strings.Join(items, " && ")
+	case *defaults.FieldDefaults_Map:
+		return m.stripEntries(f, r.Map, expr)
+	case *defaults.FieldDefaults_Bytes:
+		cond = fmt.Sprint(`string(`, expr, `) == `, strconv.Quote(string(r.Bytes)))
+		if wk != pgs.UnknownWKT {
+			cond = fmt.Sprint(expr, ` != nil && string(`, expr, `.Value) == `, strconv.Quote(string(r.Bytes)))
+		}
+	default:
+		v := m.fieldValue(f, f.Type(), m.ctx.Type(f).Value(), fd)
+		switch {
+		case f.Type().IsEmbed():
+			cond = fmt.Sprint(expr, ` != nil && proto.Equal(`, expr, `, `, v, `)`)
+		case m.isPointer(f):
+			cond = fmt.Sprint(expr, ` != nil && *`, expr, ` == `, v)
+		default:
+			cond = fmt.Sprint(expr, ` == `, v)
+		}
+	}
+	if !clear || cond == "" {
+		return pre
+	}
+	return pre + fmt.Sprint(`
+		if `, cond, ` {
+			`, m.stripClear(f, expr), `
+		}`)
+}
+
+// literalValue returns the go expression of the message literal msg of the field f,
+// its defaults being applied unless md disables them.
+func (m *Module) literalValue(f pgs.Field, md *defaults.MessageDefaults, msg protoreflect.Message) string {
+	v := m.literal(f, f.Type().Embed(), msg)
+	if md.Defaults != nil && !md.GetDefaults() {
+		return v
+	}
+	return fmt.Sprint(`func() `, m.ctx.Type(f), ` {
+		d := `, v, m.callDefault("d", "", ""), `
+		return d
+	}()`)
+}
+
+// stripClear returns the statement clearing the field f of go expression expr.
+func (m *Module) stripClear(f pgs.Field, expr string) string {
+	if f.InRealOneOf() {
+		return fmt.Sprint(`x.`, m.ctx.Name(f.OneOf()), ` = nil`)
+	}
+	switch {
+	case f.Type().IsRepeated(), f.Type().IsMap(), f.Type().IsEmbed(), f.Type().ProtoType() == pgs.BytesT, m.isPointer(f):
+		return fmt.Sprint(expr, ` = nil`)
+	}
+	return fmt.Sprint(expr, ` = `, zeroLiteral(f.Type()))
+}
+
+// stripEntries returns the statements removing the entries of the map field f of go expression expr
+// holding their default value, or clearing it if it is not merged and only holds the default entries.
+// The elements are then stripped if their defaults are applied.
+func (m *Module) stripEntries(f pgs.Field, r *defaults.MapDefaults, expr string) string {
+	var out string
+	if vd := r.GetValues(); vd.GetLiteral() == nil && len(r.GetEntries()) != 0 {
+		typ := m.ctx.Type(f)
+		var checks string
+		for _, e := range r.GetEntries() {
+			k := m.elemValue(f.Type().Key(), typ.Key(), e.GetKey())
+			eq := m.stripEqual(f.Type().Element(), typ.Element(), e.GetValue(), "v", e.GetValue().GetMessage().GetDefaults() || vd.GetDefaults())
+			if r.GetMerge() {
+				checks += fmt.Sprint(`
+					if v, ok := `, expr, `[`, k, `]; ok && `, eq, ` {
+						delete(`, expr, `, `, k, `)
+					}`)
+			} else {
+				checks += fmt.Sprint(`
+					if v, ok := `, expr, `[`, k, `]; !ok || !(`, eq, `) {
+						def = false
+					}`)
+			}
+		}
+		if r.GetMerge() {
+			out += checks + fmt.Sprint(`
+				if `, expr, ` != nil && len(`, expr, `) == 0 {
+					`, expr, ` = nil
+				}`)
+		} else {
+			out += fmt.Sprint(`
+				if len(`, expr, `) == `, len(r.GetEntries()), ` {
+					def := true`, checks, `
+					if def {
+						`, expr, ` = nil
+					}
+				}`)
+		}
+	}
+	return out + m.stripElems(expr, r.GetValues())
+}
+
+// stripElems returns the statements stripping the elements of the repeated or map field
+// of go expression expr if their defaults are applied.
+func (m *Module) stripElems(expr string, md *defaults.MessageDefaults) string {
+	if !md.GetDefaults() || md.GetLiteral() != nil {
+		return ""
+	}
+	return fmt.Sprint(`
+		for _, v := range `, expr, ` {`, m.callStrip("v"), `
+		}`)
+}
+
+// stripEqual returns the go expression reporting whether the element expr equals the value described by fd,
+// the defaults of the message values being applied if apply is true.
+func (m *Module) stripEqual(el pgs.FieldTypeElem, typ pgsgo.TypeName, fd *defaults.FieldDefaults, expr string, apply bool) string {
+	v := m.elemValue(el, typ, fd)
+	switch {
+	case el.IsEmbed():
+		if _, ok := fd.GetType().(*defaults.FieldDefaults_Message); ok && apply {
+			v = fmt.Sprint(`func() `, typ, ` {
+				d := `, v, m.callDefault("d", "", ""), `
+				return d
+			}()`)
+		}
+		return fmt.Sprint(`proto.Equal(`, expr, `, `, v, `)`)
+	case el.ProtoType() == pgs.BytesT:
+		return fmt.Sprint(`string(`, expr, `) == `, strconv.Quote(string(fd.GetBytes())))
+	}
+	return fmt.Sprint(expr, ` == `, v)
+}
+
+// callStrip returns the statements stripping the defaults of the message expr.
+func (m *Module) callStrip(expr string) string {
+	return fmt.Sprint(`
+		if s, ok := interface{}(`, expr, `).(interface{StripDefaults()}); ok && `, expr, ` != nil {
+			s.StripDefaults()
+		}`)
+}
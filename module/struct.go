@@ -0,0 +1,106 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// jsonValue parses the JSON value r as the well-known type wk.
+func jsonValue(wk pgs.WellKnownType, r string) (proto.Message, error) {
+	var v proto.Message
+	switch wk {
+	case pgs.StructWKT:
+		v = &structpb.Struct{}
+	case pgs.ValueWKT:
+		v = &structpb.Value{}
+	case pgs.ListValueWKT:
+		v = &structpb.ListValue{}
+	default:
+		return nil, fmt.Errorf("unexpected type %s for JSON, expected google.protobuf.Struct, google.protobuf.Value or google.protobuf.ListValue", wk)
+	}
+	if err := protojson.Unmarshal([]byte(r), v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// resolveJSON returns the JSON value r parsed as the well-known type wk, failing if it is invalid.
+func (m *Module) resolveJSON(wk pgs.WellKnownType, r string) proto.Message {
+	v, err := jsonValue(wk, r)
+	m.CheckErr(err, "invalid json value")
+	return v
+}
+
+// structValue returns the go expression building the JSON value r as the well-known type emb
+// of the field f, or of its elements.
+func (m *Module) structValue(f pgs.Field, emb pgs.Message, r string) string {
+	q := m.qualifiedName(f, emb, "")
+	switch v := m.resolveJSON(emb.WellKnownType(), r).(type) {
+	case *structpb.Struct:
+		return structLiteral(q, v)
+	case *structpb.ListValue:
+		return listLiteral(q, v)
+	case *structpb.Value:
+		return valueLiteral(q, v)
+	}
+	return "nil"
+}
+
+func structLiteral(q string, s *structpb.Struct) string {
+	keys := make([]string, 0, len(s.GetFields()))
+	for k := range s.GetFields() {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var fields []string
+	for _, k := range keys {
+		fields = append(fields, fmt.Sprint(strconv.Quote(k), `: `, valueLiteral(q, s.GetFields()[k])))
+	}
+	return fmt.Sprint(`&`, q, `Struct{Fields: map[string]*`, q, `Value{`, strings.Join(fields, ", "), `}}`)
+}
+
+func listLiteral(q string, l *structpb.ListValue) string {
+	var values []string
+	for _, v := range l.GetValues() {
+		values = append(values, valueLiteral(q, v))
+	}
+	return fmt.Sprint(`&`, q, `ListValue{Values: []*`, q, `Value{`, strings.Join(values, ", "), `}}`)
+}
+
+func valueLiteral(q string, v *structpb.Value) string {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		return fmt.Sprint(q, `NewNumberValue(`, strconv.FormatFloat(k.NumberValue, 'g', -1, 64), `)`)
+	case *structpb.Value_StringValue:
+		return fmt.Sprint(q, `NewStringValue(`, strconv.Quote(k.StringValue), `)`)
+	case *structpb.Value_BoolValue:
+		return fmt.Sprint(q, `NewBoolValue(`, k.BoolValue, `)`)
+	case *structpb.Value_StructValue:
+		return fmt.Sprint(q, `NewStructValue(`, structLiteral(q, k.StructValue), `)`)
+	case *structpb.Value_ListValue:
+		return fmt.Sprint(q, `NewListValue(`, listLiteral(q, k.ListValue), `)`)
+	default:
+		return fmt.Sprint(q, `NewNullValue()`)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	pgs "github.com/lyft/protoc-gen-star"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// types resolves the messages and extensions defined in the files visible
+// to the plugin, falling back to the ones linked in the plugin binary.
+type types struct {
+	files *protoregistry.Files
+	types *protoregistry.Types
+	// errs holds the errors of the files which could not be loaded, by file name.
+	errs map[string]error
+}
+
+func newTypes(pkgs map[string]pgs.Package) *types {
+	descs := make(map[string]*descriptorpb.FileDescriptorProto)
+	for _, p := range pkgs {
+		for _, f := range p.Files() {
+			descs[f.Descriptor().GetName()] = f.Descriptor()
+		}
+	}
+	t := &types{files: &protoregistry.Files{}, types: &protoregistry.Types{}, errs: make(map[string]error)}
+	loaded := make(map[string]bool)
+	for name := range descs {
+		t.load(name, descs, loaded)
+	}
+	return t
+}
+
+// load registers the file of the given name after its dependencies, recording its error
+// if it or one of its dependencies cannot be loaded.
+func (t *types) load(name string, descs map[string]*descriptorpb.FileDescriptorProto, loaded map[string]bool) error {
+	if loaded[name] {
+		return t.errs[name]
+	}
+	loaded[name] = true
+	err := func() error {
+		desc, ok := descs[name]
+		if !ok {
+			return fmt.Errorf("%s: file not found", name)
+		}
+		for _, dep := range desc.GetDependency() {
+			if err := t.load(dep, descs, loaded); err != nil {
+				return fmt.Errorf("%s: %w", dep, err)
+			}
+		}
+		fd, err := protodesc.NewFile(desc, t.files)
+		if err != nil {
+			return err
+		}
+		if err := t.files.RegisterFile(fd); err != nil {
+			return err
+		}
+		return t.register(fd.Messages(), fd.Extensions())
+	}()
+	if err != nil {
+		t.errs[name] = err
+	}
+	return err
+}
+
+// fileErr returns the error of the file f if it could not be loaded.
+func (t *types) fileErr(f pgs.File) error {
+	return t.errs[f.Descriptor().GetName()]
+}
+
+func (t *types) register(msgs protoreflect.MessageDescriptors, exts protoreflect.ExtensionDescriptors) error {
+	for i := 0; i < exts.Len(); i++ {
+		if err := t.types.RegisterExtension(dynamicpb.NewExtensionType(exts.Get(i))); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < msgs.Len(); i++ {
+		md := msgs.Get(i)
+		if err := t.types.RegisterMessage(dynamicpb.NewMessageType(md)); err != nil {
+			return err
+		}
+		if err := t.register(md.Messages(), md.Extensions()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *types) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	if mt, err := t.types.FindMessageByName(name); err == nil {
+		return mt, nil
+	}
+	return protoregistry.GlobalTypes.FindMessageByName(name)
+}
+
+func (t *types) FindMessageByURL(url string) (protoreflect.MessageType, error) {
+	if mt, err := t.types.FindMessageByURL(url); err == nil {
+		return mt, nil
+	}
+	return protoregistry.GlobalTypes.FindMessageByURL(url)
+}
+
+func (t *types) FindExtensionByName(field protoreflect.FullName) (protoreflect.ExtensionType, error) {
+	if xt, err := t.types.FindExtensionByName(field); err == nil {
+		return xt, nil
+	}
+	return protoregistry.GlobalTypes.FindExtensionByName(field)
+}
+
+func (t *types) FindExtensionByNumber(message protoreflect.FullName, field protoreflect.FieldNumber) (protoreflect.ExtensionType, error) {
+	if xt, err := t.types.FindExtensionByNumber(message, field); err == nil {
+		return xt, nil
+	}
+	return protoregistry.GlobalTypes.FindExtensionByNumber(message, field)
+}
+
+// anyValue returns the google.protobuf.Any described by r.
+func (t *types) anyValue(r *defaults.AnyDefaults) (*anypb.Any, error) {
+	url := typeURL(r.GetTypeUrl())
+	if url == "" {
+		return nil, errors.New("missing type url")
+	}
+	mt, err := t.FindMessageByURL(url)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	msg := mt.New().Interface()
+	switch v := r.GetValue().(type) {
+	case *defaults.AnyDefaults_Text:
+		err = prototext.UnmarshalOptions{Resolver: t}.Unmarshal([]byte(v.Text), msg)
+	case *defaults.AnyDefaults_Json:
+		err = protojson.UnmarshalOptions{Resolver: t}.Unmarshal([]byte(v.Json), msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", url, err)
+	}
+	return &anypb.Any{TypeUrl: url, Value: b}, nil
+}
+
+// messageValue returns the message of the given name described by the literal of md.
+func (t *types) messageValue(name string, md *defaults.MessageDefaults) (protoreflect.Message, error) {
+	mt, err := t.FindMessageByName(protoreflect.FullName(strings.TrimPrefix(name, ".")))
+	if err != nil {
+		return nil, err
+	}
+	msg := mt.New()
+	switch v := md.GetLiteral().(type) {
+	case *defaults.MessageDefaults_Value:
+		err = prototext.UnmarshalOptions{Resolver: t}.Unmarshal([]byte(v.Value), msg.Interface())
+	case *defaults.MessageDefaults_Json:
+		err = protojson.UnmarshalOptions{Resolver: t}.Unmarshal([]byte(v.Json), msg.Interface())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// typeURL returns the type url u, using the type.googleapis.com prefix
+// if u is a message name.
+func typeURL(u string) string {
+	u = strings.TrimSpace(u)
+	if u == "" || strings.Contains(u, "/") {
+		return u
+	}
+	return "type.googleapis.com/" + strings.TrimPrefix(u, ".")
+}
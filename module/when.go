@@ -0,0 +1,180 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package module
+
+import (
+	"fmt"
+	"strconv"
+
+	pgs "github.com/lyft/protoc-gen-star"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+// fieldWhen returns the conditional defaults of the field f.
+func (m *Module) fieldWhen(f pgs.Field) []*defaults.When {
+	var when []*defaults.When
+	_, err := f.Extension(defaults.E_When, &when)
+	m.CheckErr(err, "unable to read when extension from field")
+	return when
+}
+
+// CheckWhen fails if the conditional defaults of the field f do not match the fields
+// of its message or its type.
+func (m *Module) CheckWhen(f pgs.Field) {
+	when := m.fieldWhen(f)
+	if len(when) == 0 {
+		return
+	}
+	if f.InRealOneOf() {
+		m.Failf("(defaults.when) cannot be used on oneof fields")
+	}
+	for _, w := range when {
+		sf := m.whenField(f, w)
+		switch c := w.GetCondition().(type) {
+		case *defaults.When_Equals:
+			if sf.Type().IsRepeated() || sf.Type().IsMap() || sf.Type().IsEmbed() {
+				m.Failf("(defaults.when) equals cannot be used on %s", sf.Name())
+			}
+			_, err := defaults.ParseValue(m.fieldDescriptor(sf), c.Equals)
+			m.CheckErr(err, "invalid (defaults.when) equals value")
+			if e := sf.Type().Enum(); e != nil {
+				m.addImport(f, e)
+			}
+		case *defaults.When_Has:
+		default:
+			m.Failf("missing (defaults.when) condition on %s", sf.Name())
+		}
+		if w.GetValue().GetType() == nil {
+			m.Failf("missing (defaults.when) value")
+		}
+		if w.GetValue().GetMessage() != nil {
+			m.CheckMessage(f, w.GetValue())
+		}
+		m.CheckFieldRules(f.Type(), w.GetValue())
+		c, _ := m.fieldConstant(f, w.GetValue())
+		for _, v := range c.inlined().imports {
+			m.addImportPath(f, v)
+		}
+	}
+}
+
+// whenField returns the sibling field of f the condition w is evaluated on.
+func (m *Module) whenField(f pgs.Field, w *defaults.When) pgs.Field {
+	for _, v := range f.Message().Fields() {
+		if v.Name().String() == w.GetField() && v != f {
+			return v
+		}
+	}
+	m.Failf("(defaults.when) field %q not found in %s", w.GetField(), f.Message().Name())
+	return nil
+}
+
+// whenDefaults returns the statements applying the value of the first condition of when holding,
+// or the defaults rules fd of the field f if none holds.
+func (m *Module) whenDefaults(f pgs.Field, fd *defaults.FieldDefaults, when []*defaults.When) string {
+	wk := pgs.UnknownWKT
+	if emb := f.Type().Embed(); emb != nil {
+		wk = emb.WellKnownType()
+	}
+	return m.whenBranches(f, fd, when, func(v *defaults.FieldDefaults, c constant) string {
+		def, _ := m.rulesDefaults(f, v, c, wk)
+		return def
+	})
+}
+
+// whenStrip returns the statements clearing the field f of go expression expr if it holds the value
+// of the first condition of when holding, or the default value described by fd if none holds.
+func (m *Module) whenStrip(f pgs.Field, fd *defaults.FieldDefaults, when []*defaults.When, expr string) string {
+	return m.whenBranches(f, fd, when, func(v *defaults.FieldDefaults, _ constant) string {
+		return m.stripValue(f, v, expr, true)
+	})
+}
+
+// whenDiff returns the statements returning false if the field f of go expression expr is set
+// to another value than the one of the first condition of when holding, or than fd if none holds.
+func (m *Module) whenDiff(f pgs.Field, fd *defaults.FieldDefaults, when []*defaults.When, expr string) string {
+	return m.whenBranches(f, fd, when, func(v *defaults.FieldDefaults, _ constant) string {
+		return m.differs(f, v, expr) + m.diffMessages(f, v, expr)
+	})
+}
+
+// whenBranches returns the statements generated by gen for the value of the first condition of when holding,
+// or for the rules fd of the field f if none holds. It is empty if gen returns no statements.
+func (m *Module) whenBranches(f pgs.Field, fd *defaults.FieldDefaults, when []*defaults.When, gen func(v *defaults.FieldDefaults, c constant) string) string {
+	out := "\n"
+	empty := true
+	for i, w := range when {
+		c, _ := m.fieldConstant(f, w.GetValue())
+		s := gen(w.GetValue(), c.inlined())
+		empty = empty && s == ""
+		if i > 0 {
+			out += " else "
+		}
+		out += fmt.Sprint(`if `, m.whenCondition(f, w), ` {`, s, `
+			}`)
+	}
+	if fd.GetType() != nil {
+		s := gen(fd, m.defaultConstant(f, fd))
+		empty = empty && s == ""
+		out += fmt.Sprint(` else {`, s, `
+			}`)
+	}
+	if empty {
+		return ""
+	}
+	return out
+}
+
+// whenCondition returns the go expression of the condition w on the sibling of the field f.
+func (m *Module) whenCondition(f pgs.Field, w *defaults.When) string {
+	sf := m.whenField(f, w)
+	switch c := w.GetCondition().(type) {
+	case *defaults.When_Equals:
+		v, err := defaults.ParseValue(m.fieldDescriptor(sf), c.Equals)
+		m.CheckErr(err, "invalid (defaults.when) equals value")
+		if sf.Type().ProtoType() == pgs.BytesT {
+			return fmt.Sprint(`string(x.Get`, m.ctx.Name(sf), `()) == `, strconv.Quote(string(v.Bytes())))
+		}
+		return fmt.Sprint(`x.Get`, m.ctx.Name(sf), `() == `, m.valueLiteral(f, sf.Type(), v))
+	case *defaults.When_Has:
+		return m.presence("x", sf, c.Has)
+	}
+	m.Failf("missing (defaults.when) condition on %s", sf.Name())
+	return ""
+}
+
+// presence returns the go expression reporting whether the field f of the message expr is set,
+// or unset if has is false.
+func (m *Module) presence(expr string, f pgs.Field, has bool) string {
+	name := m.ctx.Name(f).String()
+	op := "!="
+	if !has {
+		op = "=="
+	}
+	switch {
+	case f.InRealOneOf():
+		v := fmt.Sprint(`func() bool { _, ok := `, expr, `.`, m.ctx.Name(f.OneOf()), `.(*`, m.ctx.OneofOption(f), `); return ok }()`)
+		if !has {
+			v = "!" + v
+		}
+		return v
+	case f.Type().IsEmbed(), m.isPointer(f), m.isNilable(f):
+		return fmt.Sprint(expr, `.`, name, ` `, op, ` nil`)
+	case f.Type().IsRepeated(), f.Type().IsMap(), f.Type().ProtoType() == pgs.BytesT:
+		return fmt.Sprint(`len(`, expr, `.`, name, `) `, op, ` 0`)
+	}
+	return fmt.Sprint(expr, `.`, name, ` `, op, ` `, zeroLiteral(f.Type()))
+}
@@ -15,17 +15,31 @@
 package tests
 
 import (
+	"bytes"
+	"errors"
+	"log"
+	"os"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	assert2 "github.com/stretchr/testify/assert"
 	require2 "github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"go.linka.cloud/protoc-gen-defaults/defaults"
+	"go.linka.cloud/protoc-gen-defaults/defaults/expr"
 	"go.linka.cloud/protoc-gen-defaults/tests/pb"
 )
 
@@ -53,6 +67,30 @@ var (
 	}
 )
 
+// testApply runs test with apply applying the defaults with the generated Default method,
+// then with defaults.Apply.
+func testApply[T proto.Message](t *testing.T, test func(t *testing.T, apply func(m T))) {
+	t.Helper()
+	t.Run("generated", func(t *testing.T) {
+		test(t, func(m T) { proto.Message(m).(interface{ Default() }).Default() })
+	})
+	t.Run("reflected", func(t *testing.T) {
+		test(t, func(m T) { defaults.Apply(m) })
+	})
+}
+
+// testApplyEqual checks that applying the defaults to a copy of msg gives want, as testApply does,
+// and that defaults.ApplyE does not fail.
+func testApplyEqual(t *testing.T, msg, want proto.Message) {
+	t.Helper()
+	require2.NoError(t, defaults.ApplyE(proto.Clone(msg)))
+	testApply(t, func(t *testing.T, apply func(m proto.Message)) {
+		m := proto.Clone(msg)
+		apply(m)
+		assert2.True(t, proto.Equal(want, m), "%v", m)
+	})
+}
+
 func TestDefaults(t *testing.T) {
 	assert := assert2.New(t)
 	require := require2.New(t)
@@ -119,3 +157,1205 @@ func TestDefaultsReflectOptionals(t *testing.T) {
 	assert.True(proto.Equal(expect, test))
 
 }
+
+func TestDefaultsRepeated(t *testing.T) {
+	assert := assert2.New(t)
+
+	expect := &pb.Repeated{
+		Strings:      []string{"one", "two"},
+		Numbers:      []int64{1, 2},
+		Fixed64S:     []uint64{42},
+		Bools:        []bool{true, false},
+		Bytes:        [][]byte{[]byte("42")},
+		Enums:        []pb.Types_Enum{pb.Types_ONE, pb.Types_TWO, pb.Types_NEGATIVE},
+		StringValues: []*wrapperspb.StringValue{wrapperspb.String("42")},
+		Durations:    []*durationpb.Duration{durationpb.New(time.Hour), durationpb.New(48 * time.Hour)},
+		Timestamps:   []*timestamppb.Timestamp{{Seconds: -562032000}},
+		Messages:     []*pb.Message{{Field: "lonely field"}, {}},
+	}
+
+	test := &pb.Repeated{}
+	test.Default()
+	assert.True(proto.Equal(expect, test))
+
+	test = &pb.Repeated{}
+	defaults.Apply(test)
+	assert.True(proto.Equal(expect, test))
+
+	expect.Strings = []string{"other"}
+	test = &pb.Repeated{Strings: []string{"other"}}
+	test.Default()
+	assert.True(proto.Equal(expect, test))
+
+	test = &pb.Repeated{Strings: []string{"other"}}
+	defaults.Apply(test)
+	assert.True(proto.Equal(expect, test))
+}
+
+func TestDefaultsMaps(t *testing.T) {
+	assert := assert2.New(t)
+
+	expect := &pb.Maps{
+		Labels:    map[string]string{"app": "defaults"},
+		Merged:    map[string]string{"one": "1", "two": "2"},
+		Enums:     map[int32]pb.Types_Enum{1: pb.Types_ONE},
+		Durations: map[bool]*durationpb.Duration{true: durationpb.New(30 * time.Second)},
+		Messages:  map[string]*pb.Message{"default": {Field: "lonely field"}},
+	}
+
+	test := &pb.Maps{}
+	test.Default()
+	assert.True(proto.Equal(expect, test))
+
+	test = &pb.Maps{}
+	defaults.Apply(test)
+	assert.True(proto.Equal(expect, test))
+
+	expect = &pb.Maps{
+		Labels:    map[string]string{"other": "value"},
+		Merged:    map[string]string{"one": "other", "two": "2"},
+		Enums:     map[int32]pb.Types_Enum{1: pb.Types_ONE},
+		Durations: map[bool]*durationpb.Duration{true: durationpb.New(30 * time.Second)},
+		Messages:  map[string]*pb.Message{"one": {Field: "lonely field"}, "two": {Field: "other"}},
+	}
+	newTest := func() *pb.Maps {
+		return &pb.Maps{
+			Labels:   map[string]string{"other": "value"},
+			Merged:   map[string]string{"one": "other"},
+			Messages: map[string]*pb.Message{"one": nil, "two": {Field: "other"}},
+		}
+	}
+
+	test = newTest()
+	test.Default()
+	assert.True(proto.Equal(expect, test))
+
+	test = newTest()
+	defaults.Apply(test)
+	assert.True(proto.Equal(expect, test))
+}
+
+func TestDefaultsElements(t *testing.T) {
+	assert := assert2.New(t)
+
+	expect := &pb.Elements{
+		Messages:    []*pb.Message{{Field: "lonely field"}, {Field: "other"}},
+		Initialized: []*pb.Message{{Field: "lonely field"}},
+		Values:      map[string]*pb.Message{"one": {Field: "lonely field"}, "two": {Field: "other"}},
+	}
+	newTest := func() *pb.Elements {
+		return &pb.Elements{
+			Messages:    []*pb.Message{{}, {Field: "other"}},
+			Initialized: []*pb.Message{nil},
+			Values:      map[string]*pb.Message{"one": {}, "two": {Field: "other"}},
+		}
+	}
+
+	test := newTest()
+	test.Default()
+	assert.True(proto.Equal(expect, test))
+
+	test = newTest()
+	defaults.Apply(test)
+	assert.True(proto.Equal(expect, test))
+
+	test = &pb.Elements{}
+	test.Default()
+	assert.True(proto.Equal(&pb.Elements{}, test))
+
+	test = &pb.Elements{}
+	defaults.Apply(test)
+	assert.True(proto.Equal(&pb.Elements{}, test))
+}
+
+func TestDefaultsEnumName(t *testing.T) {
+	testApply(t, func(t *testing.T, apply func(m *pb.Types)) {
+		assert := assert2.New(t)
+
+		test := &pb.Types{}
+		apply(test)
+		assert.Equal(pb.Types_TWO, test.EnumName)
+		assert.Equal(pb.Types_NEGATIVE, test.EnumFullName)
+		assert.Equal(pb.Types_NEGATIVE, test.GetOptionalEnumName())
+
+		test = &pb.Types{EnumName: pb.Types_ONE, OptionalEnumName: pb.Types_NONE.Enum()}
+		apply(test)
+		assert.Equal(pb.Types_ONE, test.EnumName)
+		assert.Equal(pb.Types_NONE, test.GetOptionalEnumName())
+	})
+}
+
+func TestDefaultsAny(t *testing.T) {
+	testApply(t, func(t *testing.T, apply func(m *pb.Types)) {
+		assert := assert2.New(t)
+		require := require2.New(t)
+
+		test := &pb.Types{}
+		apply(test)
+		require.NotNil(test.Any)
+		assert.Equal("type.googleapis.com/tests.Message", test.Any.TypeUrl)
+		msg := &pb.Message{}
+		require.NoError(test.Any.UnmarshalTo(msg))
+		assert.Equal("packed", msg.Field)
+
+		require.NotNil(test.AnyJson)
+		d := &durationpb.Duration{}
+		require.NoError(test.AnyJson.UnmarshalTo(d))
+		assert.Equal(time.Second, d.AsDuration())
+	})
+}
+
+func TestDefaultsStruct(t *testing.T) {
+	require := require2.New(t)
+
+	expect, err := structpb.NewStruct(map[string]interface{}{
+		"string": "value",
+		"number": 42,
+		"bool":   true,
+		"null":   nil,
+		"list":   []interface{}{1, "two"},
+		"struct": map[string]interface{}{"key": "value"},
+	})
+	require.NoError(err)
+	list, err := structpb.NewList([]interface{}{0.42, map[string]interface{}{"key": false}})
+	require.NoError(err)
+
+	testApply(t, func(t *testing.T, apply func(m *pb.Types)) {
+		assert := assert2.New(t)
+
+		test := &pb.Types{}
+		apply(test)
+		assert.True(proto.Equal(expect, test.Struct))
+		assert.True(proto.Equal(structpb.NewStringValue("value"), test.Value))
+		assert.True(proto.Equal(list, test.ListValue))
+	})
+}
+
+func TestDefaultsMessageLiteral(t *testing.T) {
+	expect := &pb.Literal{
+		Text: &pb.Literal_Policy{
+			MaxAttempts: 3,
+			Backoff:     durationpb.New(time.Second),
+			Codes:       []string{"UNAVAILABLE"},
+			Weights:     map[string]int32{"a": 1},
+			Enum:        pb.Types_TWO,
+			Name:        proto.String("retry"),
+			Kind:        &pb.Literal_Policy_Label{Label: "text"},
+			Nested:      &pb.Message{Field: "nested"},
+			Data:        []byte("raw"),
+			Ratio:       0.5,
+			Timeout:     10,
+		},
+		Json:  &pb.Literal_Policy{MaxAttempts: 5, Backoff: durationpb.New(2 * time.Second)},
+		Items: []*pb.Literal_Policy{{MaxAttempts: 1}},
+	}
+	merged := &pb.Literal{
+		Text: &pb.Literal_Policy{
+			MaxAttempts: 7,
+			Backoff:     &durationpb.Duration{Seconds: 1, Nanos: 42},
+			Codes:       []string{"UNAVAILABLE"},
+			Weights:     map[string]int32{"a": 1},
+			Enum:        pb.Types_TWO,
+			Name:        proto.String(""),
+			Kind:        &pb.Literal_Policy_Message{Message: &pb.Message{}},
+			Nested:      &pb.Message{Field: "nested"},
+			Data:        []byte("raw"),
+			Ratio:       0.5,
+			Timeout:     10,
+		},
+		Json:   &pb.Literal_Policy{MaxAttempts: 5, Backoff: durationpb.New(2 * time.Second), Timeout: 1},
+		Items:  []*pb.Literal_Policy{{}},
+		Values: map[string]*pb.Literal_Policy{"nil": {MaxAttempts: 2}, "set": {MaxAttempts: 4}},
+	}
+	newMerged := func() *pb.Literal {
+		return &pb.Literal{
+			Text: &pb.Literal_Policy{
+				MaxAttempts: 7,
+				Backoff:     &durationpb.Duration{Nanos: 42},
+				Name:        proto.String(""),
+				Kind:        &pb.Literal_Policy_Message{Message: &pb.Message{}},
+			},
+			Json:   &pb.Literal_Policy{Timeout: 1},
+			Items:  []*pb.Literal_Policy{{}},
+			Values: map[string]*pb.Literal_Policy{"nil": nil, "set": {MaxAttempts: 4}},
+		}
+	}
+
+	testApply(t, func(t *testing.T, apply func(m *pb.Literal)) {
+		assert := assert2.New(t)
+
+		test := &pb.Literal{}
+		apply(test)
+		assert.True(proto.Equal(expect, test))
+
+		test = newMerged()
+		apply(test)
+		assert.True(proto.Equal(merged, test))
+	})
+}
+
+func TestDefaultsMessageLiteralExtension(t *testing.T) {
+	assert := assert2.New(t)
+
+	test := &pb.LiteralExtension{Options: &descriptorpb.FieldOptions{}}
+	defaults.Apply(test)
+	assert.True(test.Options.GetDeprecated())
+	assert.Equal("default", proto.GetExtension(test.Options, defaults.E_Value).(*defaults.FieldDefaults).GetString_())
+}
+
+func TestDefaultsApplyInitialize(t *testing.T) {
+	want := &pb.Initialize{Message: &pb.Message{Field: "lonely field"}}
+	t.Run("new", func(t *testing.T) {
+		testApplyEqual(t, &pb.Initialize{}, want)
+	})
+	t.Run("set", func(t *testing.T) {
+		testApplyEqual(t, &pb.Initialize{Message: &pb.Message{}}, want)
+	})
+}
+
+func TestDefaultsGenerate(t *testing.T) {
+	require := require2.New(t)
+
+	hostname, err := os.Hostname()
+	require.NoError(err)
+
+	testApply(t, func(t *testing.T, apply func(m *pb.Generated)) {
+		assert := assert2.New(t)
+
+		test := &pb.Generated{}
+		apply(test)
+		assert.Regexp(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, test.UuidV4)
+		assert.Regexp(`^[0-9a-f]{8}-[0-9a-f]{4}-7[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`, test.UuidV7)
+		assert.Regexp(`^[0-9A-HJKMNP-TV-Z]{26}$`, test.Ulid)
+		assert.Regexp(`^[0-9a-v]{20}$`, test.Xid)
+		assert.Regexp(`^[0-9a-f]{24}$`, test.ObjectId)
+		assert.Regexp(`^[0-9a-f]{16}$`, test.RandomHex)
+		assert.Equal(hostname, test.Hostname)
+		assert.Len(test.UuidBytes, 16)
+		assert.Len(test.RandomBytes, defaults.DefaultRandomLength)
+		assert.Regexp(`^[0-9A-HJKMNP-TV-Z]{26}$`, test.GetOptionalId())
+		assert.Regexp(`^[0-9a-v]{20}$`, test.GetStringValue().GetValue())
+		assert.Len(test.GetBytesValue().GetValue(), 12)
+
+		other := &pb.Generated{}
+		apply(other)
+		assert.NotEqual(test.UuidV4, other.UuidV4)
+		assert.NotEqual(test.ObjectId, other.ObjectId)
+
+		test = &pb.Generated{UuidV4: "id", OptionalId: proto.String("")}
+		apply(test)
+		assert.Equal("id", test.UuidV4)
+		assert.Equal("", test.GetOptionalId())
+	})
+}
+
+func TestDefaultsRelativeTimestamp(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	paris, err := time.LoadLocation("Europe/Paris")
+	require.NoError(err)
+	gmt5, err := time.LoadLocation("Etc/GMT+5")
+	require.NoError(err)
+	now := time.Date(2021, 6, 15, 22, 30, 0, 0, time.UTC)
+	for expr, want := range map[string]time.Time{
+		"now":                         now,
+		"now+30d":                     now.Add(30 * 24 * time.Hour),
+		"NOW - 1h30m":                 now.Add(-90 * time.Minute),
+		"today":                       time.Date(2021, 6, 15, 0, 0, 0, 0, time.UTC),
+		"tomorrow 00:00 UTC":          time.Date(2021, 6, 16, 0, 0, 0, 0, time.UTC),
+		"yesterday 12:15:30":          time.Date(2021, 6, 14, 12, 15, 30, 0, time.UTC),
+		"today Europe/Paris":          time.Date(2021, 6, 16, 0, 0, 0, 0, paris),
+		"today 08:00 Europe/Paris+1w": time.Date(2021, 6, 23, 8, 0, 0, 0, paris),
+		"today Etc/GMT+5":             time.Date(2021, 6, 15, 0, 0, 0, 0, gmt5),
+		"today 12:00 Etc/GMT+5 - 1h":  time.Date(2021, 6, 15, 11, 0, 0, 0, gmt5),
+		"today UTC+1d":                time.Date(2021, 6, 16, 0, 0, 0, 0, time.UTC),
+	} {
+		got, err := defaults.RelativeTime(expr, now)
+		require.NoError(err, expr)
+		assert.True(want.Equal(got), "%s: expected %v, got %v", expr, want, got)
+	}
+	for _, expr := range []string{"", "later", "now 08:00", "now UTC", "today 25:00", "today Nowhere/City", "now+1x", "todayUTC", "today Etc/GMT+5+1x"} {
+		_, err := defaults.RelativeTime(expr, now)
+		assert.Error(err, expr)
+	}
+
+	testApply(t, func(t *testing.T, apply func(m *pb.Timestamps)) {
+		assert := assert2.New(t)
+		require := require2.New(t)
+
+		before := time.Now()
+		test := &pb.Timestamps{}
+		apply(test)
+		after := time.Now()
+		today := time.Date(before.UTC().Year(), before.UTC().Month(), before.UTC().Day(), 0, 0, 0, 0, time.UTC)
+		assert.WithinDuration(before.Add(30*24*time.Hour), test.ExpiresAt.AsTime(), after.Sub(before)+time.Second)
+		assert.WithinDuration(before.Add(-time.Hour), test.NotBefore.AsTime(), after.Sub(before)+time.Second)
+		assert.Equal(today, test.Today.AsTime())
+		assert.Equal(today.AddDate(0, 0, 1), test.Tomorrow.AsTime())
+		y, m, d := before.In(paris).Date()
+		assert.True(time.Date(y, m, d, 8, 30, 0, 0, paris).Equal(test.Morning.AsTime()))
+		require.Len(test.Windows, 2)
+		assert.Equal(today.AddDate(0, 0, -1), test.Windows[0].AsTime())
+		assert.Equal(today.Add(12*time.Hour), test.Windows[1].AsTime())
+
+		ts := timestamppb.New(time.Unix(0, 0))
+		test = &pb.Timestamps{ExpiresAt: ts}
+		apply(test)
+		assert.Equal(ts, test.ExpiresAt)
+	})
+}
+
+func TestDefaultsEnv(t *testing.T) {
+	assert := assert2.New(t)
+
+	env := map[string]string{
+		"DEFAULTS_TEST_INT32":       "-42",
+		"DEFAULTS_TEST_SFIXED64":    "42",
+		"DEFAULTS_TEST_BOOL":        "true",
+		"DEFAULTS_TEST_BYTES":       "bytes",
+		"DEFAULTS_TEST_ENUM":        "tests.Types.NEGATIVE",
+		"DEFAULTS_TEST_OPTIONAL":    "optional",
+		"DEFAULTS_TEST_NOT_BEFORE":  "1952-03-11T00:00:00Z",
+		"DEFAULTS_TEST_INT64_VALUE": "43",
+		"DEFAULTS_TEST_INVALID":     "not a number",
+	}
+	for k, v := range env {
+		os.Setenv(k, v)
+		defer os.Unsetenv(k)
+	}
+	expect := &pb.Env{
+		Int32:      -42,
+		Uint64:     42,
+		Sfixed64:   42,
+		Float:      0.42,
+		Bool:       true,
+		String_:    "fallback",
+		Bytes:      []byte("bytes"),
+		Enum:       pb.Types_NEGATIVE,
+		Optional:   proto.String("optional"),
+		Timeout:    durationpb.New(30 * time.Second),
+		NotBefore:  &timestamppb.Timestamp{Seconds: -562032000},
+		Int64Value: wrapperspb.Int64(43),
+	}
+	for _, apply := range []func(m *pb.Env) error{
+		(*pb.Env).DefaultE,
+		func(m *pb.Env) error { return defaults.ApplyE(m) },
+	} {
+		test := &pb.Env{}
+		err := apply(test)
+		assert.True(proto.Equal(expect, test))
+		if assert.Error(err) {
+			assert.Contains(err.Error(), "invalid: DEFAULTS_TEST_INVALID: ")
+		}
+
+		test = &pb.Env{Int32: 1, String_: "set", Optional: proto.String(""), Invalid: 1}
+		assert.NoError(apply(test))
+		assert.Equal(int32(1), test.Int32)
+		assert.Equal("set", test.String_)
+		assert.Equal("", test.GetOptional())
+	}
+	testApply(t, func(t *testing.T, apply func(m *pb.Env)) {
+		assert := assert2.New(t)
+
+		test := &pb.Env{}
+		apply(test)
+		assert.True(proto.Equal(expect, test))
+	})
+}
+
+func TestDefaultsErrors(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	os.Setenv("DEFAULTS_TEST_INVALID", "not a number")
+	defer os.Unsetenv("DEFAULTS_TEST_INVALID")
+
+	for _, apply := range []func(m *pb.Errors) error{
+		(*pb.Errors).DefaultE,
+		func(m *pb.Errors) error { return defaults.ApplyE(m) },
+	} {
+		test := &pb.Errors{Envs: map[string]*pb.Env{"a": {}, "b": {Invalid: 1}}}
+		err := apply(test)
+		require.Error(err)
+		var errs defaults.Errors
+		require.True(errors.As(err, &errs))
+		require.Len(errs, 2)
+		assert.Equal("env.invalid", errs[0].Path)
+		assert.Equal(`envs["a"].invalid`, errs[1].Path)
+		var nerr *strconv.NumError
+		assert.True(errors.As(errs[0], &nerr))
+		assert.Equal(int32(0), test.Env.Invalid)
+		assert.Equal("fallback", test.Env.String_)
+		assert.Equal("fallback", test.Envs["b"].String_)
+
+		os.Setenv("DEFAULTS_TEST_INVALID", "42")
+		test = &pb.Errors{}
+		require.NoError(apply(test))
+		assert.Equal(int32(42), test.Env.Invalid)
+		os.Setenv("DEFAULTS_TEST_INVALID", "not a number")
+	}
+}
+
+func TestDefaultsErrorHandler(t *testing.T) {
+	os.Setenv("DEFAULTS_TEST_INVALID", "not a number")
+	defer os.Unsetenv("DEFAULTS_TEST_INVALID")
+
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	testApply(t, func(t *testing.T, apply func(m *pb.Env)) {
+		buf.Reset()
+		test := &pb.Env{}
+		apply(test)
+		assert2.Zero(t, test.Invalid)
+		assert2.Contains(t, buf.String(), "defaults: invalid: DEFAULTS_TEST_INVALID: ")
+	})
+}
+
+func TestDefaultsApplyWithOptions(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	t.Run("oneof", func(t *testing.T) {
+		test := &pb.Types{Oneof: &pb.Types_One{One: &pb.OneOfOne{}}}
+		defaults.Apply(test)
+		assert.NotNil(test.GetOne())
+	})
+
+	t.Run("override", func(t *testing.T) {
+		test := &pb.Types{Int32: 1, String_: "1", Duration: durationpb.New(time.Second), Oneof: &pb.Types_Four{Four: pb.Types_TWO}}
+		require.NoError(defaults.ApplyWithOptions(test, defaults.WithOverride()))
+		assert.Equal(int32(42), test.Int32)
+		assert.Equal("42", test.String_)
+		assert.Equal(48*time.Hour, test.Duration.AsDuration())
+		assert.Equal(pb.Types_ONE, test.GetFour())
+
+		repeated := &pb.Repeated{Strings: []string{"a", "b", "c"}}
+		require.NoError(defaults.ApplyWithOptions(repeated, defaults.WithOverride()))
+		assert.Equal([]string{"one", "two"}, repeated.Strings)
+
+		maps := &pb.Maps{Labels: map[string]string{"key": "value"}, Merged: map[string]string{"one": "one", "three": "3"}}
+		require.NoError(defaults.ApplyWithOptions(maps, defaults.WithOverride()))
+		assert.Equal(map[string]string{"app": "defaults"}, maps.Labels)
+		assert.Equal(map[string]string{"one": "1", "two": "2", "three": "3"}, maps.Merged)
+
+		literal := &pb.Literal{}
+		require.NoError(defaults.ApplyWithOptions(literal))
+		literal.Text.MaxAttempts = 1
+		require.NoError(defaults.ApplyWithOptions(literal, defaults.WithOverride()))
+		assert.Equal(uint32(3), literal.Text.MaxAttempts)
+	})
+
+	t.Run("max depth", func(t *testing.T) {
+		test := &pb.Errors{}
+		require.NoError(defaults.ApplyWithOptions(test, defaults.WithMaxDepth(1)))
+		require.NotNil(test.Env)
+		assert.Empty(test.Env.String_)
+	})
+
+	t.Run("clock", func(t *testing.T) {
+		now := time.Date(2021, 6, 15, 10, 0, 0, 0, time.UTC)
+		clock := defaults.WithClock(func() time.Time { return now })
+
+		test := &pb.Types{}
+		require.NoError(defaults.ApplyWithOptions(test, clock))
+		assert.Equal(now, test.Timestamp.AsTime())
+
+		ts := &pb.Timestamps{}
+		require.NoError(defaults.ApplyWithOptions(ts, clock))
+		assert.Equal(now.Add(30*24*time.Hour), ts.ExpiresAt.AsTime())
+		assert.Equal(time.Date(2021, 6, 16, 0, 0, 0, 0, time.UTC), ts.Tomorrow.AsTime())
+	})
+
+	t.Run("resolver", func(t *testing.T) {
+		test := &pb.Types{}
+		err := defaults.ApplyWithOptions(test, defaults.WithResolver(&protoregistry.Types{}))
+		var errs defaults.Errors
+		require.True(errors.As(err, &errs))
+		require.Len(errs, 2)
+		assert.Equal("any", errs[0].Path)
+		assert.Equal("any_json", errs[1].Path)
+		assert.True(errors.Is(errs[0], protoregistry.NotFound))
+		assert.Nil(test.Any)
+		assert.Equal(int32(42), test.Int32)
+
+		types := &protoregistry.Types{}
+		require.NoError(types.RegisterMessage((&pb.Message{}).ProtoReflect().Type()))
+		require.NoError(types.RegisterMessage((&durationpb.Duration{}).ProtoReflect().Type()))
+		test = &pb.Types{}
+		require.NoError(defaults.ApplyWithOptions(test, defaults.WithResolver(types)))
+		assert.NotNil(test.Any)
+	})
+
+	t.Run("without initialize", func(t *testing.T) {
+		test := &pb.Types{}
+		require.NoError(defaults.ApplyWithOptions(test, defaults.WithoutInitialize()))
+		assert.Nil(test.Message)
+		assert.Nil(test.Oneof)
+		assert.Equal(int32(42), test.Int32)
+
+		literal := &pb.Literal{}
+		require.NoError(defaults.ApplyWithOptions(literal, defaults.WithoutInitialize()))
+		assert.NotNil(literal.Text)
+	})
+
+	t.Run("field mask", func(t *testing.T) {
+		test := &pb.Test{MessageField: &pb.Test{}}
+		require.NoError(defaults.ApplyWithOptions(test, defaults.WithFieldMask(&fieldmaskpb.FieldMask{
+			Paths: []string{"string_field", "message_field.number_field"},
+		})))
+		assert.Equal("string_field", test.StringField)
+		assert.Zero(test.NumberField)
+		assert.Nil(test.NumberValueField)
+		assert.Equal(int64(42), test.MessageField.NumberField)
+		assert.Empty(test.MessageField.StringField)
+
+		test = &pb.Test{MessageField: &pb.Test{}}
+		require.NoError(defaults.ApplyWithOptions(test, defaults.WithFieldMask(&fieldmaskpb.FieldMask{
+			Paths: []string{"message_field"},
+		})))
+		assert.Empty(test.StringField)
+		assert.Equal("string_field", test.MessageField.StringField)
+	})
+}
+
+func TestDefaultsReflectCache(t *testing.T) {
+	assert := assert2.New(t)
+	clock := defaults.WithClock(func() time.Time { return time.Unix(42, 0) })
+
+	expect := &pb.Types{}
+	expect.Default()
+	expect.Timestamp = timestamppb.New(time.Unix(42, 0))
+
+	var wg sync.WaitGroup
+	results := make([]*pb.Types, 16)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = &pb.Types{}
+			assert.NoError(defaults.ApplyWithOptions(results[i], clock))
+		}(i)
+	}
+	wg.Wait()
+	for _, v := range results {
+		assert.True(proto.Equal(expect, v))
+	}
+
+	// the cached values must not be shared between messages
+	results[0].Duration.Seconds = 1
+	results[0].Struct.Fields["string"] = structpb.NewStringValue("other")
+	results[0].Bytes[0] = 'x'
+	test := &pb.Types{}
+	assert.NoError(defaults.ApplyWithOptions(test, clock))
+	assert.True(proto.Equal(expect, test))
+}
+
+func BenchmarkDefaults(b *testing.B) {
+	for _, v := range []struct {
+		name string
+		new  func() proto.Message
+	}{
+		{name: "Test", new: func() proto.Message { return &pb.Test{} }},
+		{name: "Types", new: func() proto.Message { return &pb.Types{} }},
+		{name: "Maps", new: func() proto.Message { return &pb.Maps{} }},
+	} {
+		b.Run(v.name+"/Default", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				v.new().(interface{ Default() }).Default()
+			}
+		})
+		b.Run(v.name+"/Apply", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				defaults.Apply(v.new())
+			}
+		})
+	}
+}
+
+func TestDefaultsReport(t *testing.T) {
+	assert := assert2.New(t)
+
+	for _, v := range []func() proto.Message{
+		func() proto.Message { return &pb.Test{} },
+		func() proto.Message { return &pb.Types{} },
+		func() proto.Message { return &pb.Types{Oneof: &pb.Types_Four{Four: pb.Types_TWO}} },
+		func() proto.Message {
+			return &pb.Literal{Text: &pb.Literal_Policy{MaxAttempts: 9}, Values: map[string]*pb.Literal_Policy{"a": nil}}
+		},
+		func() proto.Message { return &pb.Maps{} },
+		func() proto.Message { return &pb.Repeated{} },
+		func() proto.Message {
+			return &pb.Elements{Messages: []*pb.Message{{}}, Initialized: []*pb.Message{nil}, Values: map[string]*pb.Message{"a": {Field: "set"}}}
+		},
+		func() proto.Message { return &pb.Proto2{} },
+	} {
+		generated, reflected := v(), v()
+		paths := generated.(interface{ DefaultReport() []defaults.FieldPath }).DefaultReport()
+		assert.Equal(paths, defaults.ApplyReport(reflected), "%T", generated)
+	}
+
+	paths := (&pb.Elements{Initialized: []*pb.Message{nil}, Values: map[string]*pb.Message{"a": {Field: "set"}}}).DefaultReport()
+	assert.Equal([]defaults.FieldPath{"initialized[0]", "initialized[0].field"}, paths)
+
+	paths = (&pb.Maps{Merged: map[string]string{"one": "one"}}).DefaultReport()
+	assert.Equal([]defaults.FieldPath{`labels["app"]`, `merged["two"]`, "enums[1]", "durations[true]", `messages["default"]`, `messages["default"].field`}, paths)
+
+	paths = (&pb.Types{Int32: 1, Oneof: &pb.Types_One{}}).DefaultReport()
+	assert.NotContains(paths, defaults.FieldPath("int32"))
+	assert.NotContains(paths, defaults.FieldPath("two"))
+}
+
+func TestDefaultsStrip(t *testing.T) {
+	assert := assert2.New(t)
+
+	for _, v := range []func() proto.Message{
+		func() proto.Message { return &pb.Test{} },
+		func() proto.Message { return &pb.Types{} },
+		func() proto.Message { return &pb.Types{Oneof: &pb.Types_Four{Four: pb.Types_TWO}} },
+		func() proto.Message { return &pb.Literal{Values: map[string]*pb.Literal_Policy{"a": nil}} },
+		func() proto.Message { return &pb.Maps{} },
+		func() proto.Message { return &pb.Repeated{} },
+		func() proto.Message {
+			return &pb.Elements{Messages: []*pb.Message{{}}, Initialized: []*pb.Message{nil}, Values: map[string]*pb.Message{"a": {Field: "set"}}}
+		},
+		func() proto.Message { return &pb.Proto2{} },
+		func() proto.Message { return &pb.Initialize{} },
+		func() proto.Message { return &pb.Initialize{Message: &pb.Message{Field: "lonely field"}} },
+		func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_CLUSTER} },
+		func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_CLUSTER, Replicas: 1} },
+		func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_STANDALONE} },
+		func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_STANDALONE, Replicas: 3} },
+		func() proto.Message { return &pb.Conditional{Endpoint: proto.String("remote")} },
+	} {
+		m := v()
+		defaults.Apply(m)
+		reflected, generated := proto.Clone(m), proto.Clone(m)
+		defaults.Strip(reflected)
+		generated.(interface{ StripDefaults() }).StripDefaults()
+		assert.True(proto.Equal(reflected, generated), "%T", m)
+		defaults.Apply(reflected)
+		assert.True(proto.Equal(m, reflected), "%T", m)
+		generated.(interface{ Default() }).Default()
+		assert.True(proto.Equal(m, generated), "%T", m)
+	}
+
+	elements := &pb.Elements{Messages: []*pb.Message{nil, {}}}
+	elements.StripDefaults()
+	assert.Nil(elements.Messages[0])
+
+	test := &pb.Test{}
+	test.Default()
+	test.StripDefaults()
+	assert.Equal("", test.StringField)
+	assert.Zero(test.NumberField)
+	assert.Nil(test.NumberValueField)
+	assert.Nil(test.Bytes)
+	assert.Nil(test.Oneof)
+	assert.NotNil(test.TimeValueField)
+
+	test = &pb.Test{NumberField: 1, StringValueField: wrapperspb.String("other")}
+	test.Default()
+	test.StripDefaults()
+	assert.Equal(int64(1), test.NumberField)
+	assert.Equal("other", test.StringValueField.GetValue())
+}
+
+func TestDefaultsDiff(t *testing.T) {
+	assert := assert2.New(t)
+
+	for _, v := range []struct {
+		name      string
+		new       func() proto.Message
+		isDefault bool
+	}{
+		{name: "maps", new: func() proto.Message { return &pb.Maps{} }, isDefault: true},
+		{name: "maps entry", new: func() proto.Message { return &pb.Maps{Labels: map[string]string{"app": "other"}} }},
+		{name: "maps merged entry", new: func() proto.Message { return &pb.Maps{Merged: map[string]string{"three": "3"}} }},
+		{name: "maps value", new: func() proto.Message { return &pb.Maps{Messages: map[string]*pb.Message{"a": {Field: "set"}}} }},
+		{name: "repeated", new: func() proto.Message { return &pb.Repeated{} }, isDefault: true},
+		{name: "repeated item", new: func() proto.Message { return &pb.Repeated{Numbers: []int64{2, 1}} }},
+		{name: "literal", new: func() proto.Message { return &pb.Literal{} }, isDefault: true},
+		{name: "literal field", new: func() proto.Message { return &pb.Literal{Text: &pb.Literal_Policy{MaxAttempts: 9}} }},
+		{name: "elements", new: func() proto.Message { return &pb.Elements{Messages: []*pb.Message{nil, {}}} }, isDefault: true},
+		{name: "elements value", new: func() proto.Message { return &pb.Elements{Messages: []*pb.Message{{Field: "set"}}} }},
+		{name: "initialize", new: func() proto.Message { return &pb.Initialize{Message: &pb.Message{Field: "lonely field"}} }, isDefault: true},
+		{name: "initialize field", new: func() proto.Message { return &pb.Initialize{Message: &pb.Message{Field: "set"}} }},
+		{name: "when", new: func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_CLUSTER} }, isDefault: true},
+		{name: "when other value", new: func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_CLUSTER, Replicas: 1} }},
+		{name: "when default", new: func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_STANDALONE} }, isDefault: true},
+		{name: "when default other value", new: func() proto.Message { return &pb.Conditional{Mode: pb.Conditional_STANDALONE, Replicas: 3} }},
+	} {
+		reflected, generated := v.new(), v.new()
+		defaults.Apply(reflected)
+		generated.(interface{ Default() }).Default()
+		assert.Equal(v.isDefault, defaults.IsDefault(reflected), v.name)
+		assert.Equal(v.isDefault, generated.(interface{ IsDefault() bool }).IsDefault(), v.name)
+	}
+
+	test := &pb.Test{}
+	test.Default()
+	test.NumberField = 1
+	test.TimeValueField = nil
+	assert.False(test.IsDefault())
+	diffs := map[defaults.FieldPath]defaults.FieldDiff{}
+	for _, v := range defaults.Diff(test) {
+		assert.NotContains(diffs, v.Path)
+		diffs[v.Path] = v
+	}
+	assert.Equal(int64(42), diffs["number_field"].Default.Int())
+	assert.Equal(int64(1), diffs["number_field"].Value.Int())
+	assert.True(diffs["number_field"].Differs)
+	assert.Equal("string_field", diffs["string_field"].Value.String())
+	assert.False(diffs["string_field"].Differs)
+	assert.False(diffs["time_value_field"].Default.IsValid())
+	assert.False(diffs["time_value_field"].Value.IsValid())
+	assert.False(diffs["time_value_field"].Differs)
+	test.NumberField = 42
+	assert.True(test.IsDefault())
+	assert.True(defaults.IsDefault(test))
+
+	test = &pb.Test{}
+	test.Default()
+	assert.NotNil(test.TimeValueField)
+	assert.True(test.IsDefault())
+	assert.True(defaults.IsDefault(test))
+
+	for _, v := range defaults.Diff(&pb.Generated{}) {
+		assert.False(v.Default.IsValid(), v.Path)
+	}
+}
+
+func TestDefaultsMask(t *testing.T) {
+	assert := assert2.New(t)
+
+	test := &pb.Test{}
+	assert.NoError(defaults.ApplyMask(test, &fieldmaskpb.FieldMask{Paths: []string{"number_field", "oneof"}}))
+	assert.Equal(int64(42), test.NumberField)
+	assert.NotNil(test.GetTwo())
+	assert.Equal("", test.StringField)
+	assert.Nil(test.TimeValueField)
+
+	assert.NoError(defaults.ApplyMask(test, nil))
+	assert.Equal("", test.StringField)
+
+	test = &pb.Test{}
+	test.Default()
+	test.NumberField = 1
+	test.StringField = "other"
+	test.Oneof = &pb.Test_Four{Four: pb.Test_TWO}
+	test.MessageField = &pb.Test{NumberField: 2, StringField: "nested"}
+	assert.NoError(defaults.Reset(test, &fieldmaskpb.FieldMask{Paths: []string{"number_field", "oneof", "message_field.number_field"}}))
+	assert.Equal(int64(42), test.NumberField)
+	assert.Equal("other", test.StringField)
+	assert.NotNil(test.GetTwo())
+	assert.Equal(int64(42), test.MessageField.NumberField)
+	assert.Equal("nested", test.MessageField.StringField)
+
+	test.Oneof = &pb.Test_Three{Three: &pb.OneOfThree{}}
+	assert.NoError(defaults.Reset(test, &fieldmaskpb.FieldMask{Paths: []string{"three"}}))
+	assert.Nil(test.Oneof)
+
+	maps := &pb.Maps{Messages: map[string]*pb.Message{"a": {Field: "a"}, "b": {Field: "b"}}, Labels: map[string]string{"app": "other"}}
+	assert.NoError(defaults.Reset(maps, &fieldmaskpb.FieldMask{Paths: []string{"messages.field", "labels"}}))
+	assert.Equal("lonely field", maps.Messages["a"].Field)
+	assert.Equal("lonely field", maps.Messages["b"].Field)
+	assert.Equal(map[string]string{"app": "defaults"}, maps.Labels)
+	assert.Nil(maps.Merged)
+
+	for _, v := range []string{"unknown", "number_field.value", "oneof.one", "message_field.unknown"} {
+		assert.Error(defaults.Reset(&pb.Test{}, &fieldmaskpb.FieldMask{Paths: []string{v}}), v)
+		assert.Error(defaults.ApplyMask(&pb.Test{}, &fieldmaskpb.FieldMask{Paths: []string{v}}), v)
+	}
+}
+
+func TestDefaultsConstructors(t *testing.T) {
+	assert := assert2.New(t)
+
+	test := pb.NewTest()
+	assert.Equal(int64(42), test.NumberField)
+	assert.Equal("string_field", test.StringField)
+
+	test = pb.NewTestWith(func(x *pb.Test) {
+		x.NumberField = 1
+	})
+	assert.Equal(int64(1), test.NumberField)
+	assert.Equal("string_field", test.StringField)
+	assert.Equal("string_field", pb.NewTestWith(nil).StringField)
+
+	assert.Equal("string_field", pb.NewOneOfTwo().StringField)
+	assert.Equal("", pb.NewOneOfThree().StringField)
+	assert.Equal(uint32(10), pb.NewLiteral_Policy().Timeout)
+
+	for _, v := range []proto.Message{pb.NewRepeated(), pb.NewMaps(), pb.NewLiteral()} {
+		m := v.ProtoReflect().New().Interface()
+		m.(interface{ Default() }).Default()
+		assert.True(proto.Equal(v, m), "%T", v)
+	}
+}
+
+func TestDefaultsConstants(t *testing.T) {
+	assert := assert2.New(t)
+
+	test := &pb.Test{}
+	test.Default()
+	assert.Equal(int64(42), pb.Test_Default_NumberField)
+	assert.Equal(pb.Test_Default_NumberField, test.NumberField)
+	assert.Equal(pb.Test_Default_EnumField, test.EnumField)
+	assert.Equal(pb.Test_Default_NumberValueField, test.NumberValueField.GetValue())
+	assert.Equal(42*7*24*time.Hour, pb.Test_Default_DurationValueField)
+	assert.Equal(pb.Test_Default_DurationValueField, test.DurationValueField.AsDuration())
+	assert.True(pb.Test_Default_TimeValueFieldWithDefault.Equal(test.TimeValueFieldWithDefault.AsTime()))
+
+	test.Bytes[0] = 'x'
+	assert.Equal([]byte("??"), pb.Test_Default_Bytes)
+
+	types := &pb.Types{}
+	types.Default()
+	assert.True(proto.Equal(pb.Types_Default_Any, types.Any))
+	types.Any.TypeUrl = ""
+	assert.NotEmpty(pb.Types_Default_Any.TypeUrl)
+}
+
+func TestDefaultsProto2(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	generated, reflected := &pb.Proto2{}, &pb.Proto2{}
+	generated.Default()
+	require.NoError(defaults.ApplyE(reflected))
+	assert.True(proto.Equal(generated, reflected))
+
+	b, err := proto.Marshal(generated)
+	require.NoError(err)
+	test := &pb.Proto2{}
+	require.NoError(proto.Unmarshal(b, test))
+	for _, v := range []string{"number", "name", "flag", "level", "data", "ratio", "fixed", "id", "both", "level_both", "declared"} {
+		assert.True(test.ProtoReflect().Has(test.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(v))), v)
+	}
+	assert.False(test.ProtoReflect().Has(test.ProtoReflect().Descriptor().Fields().ByName("none")))
+	assert.Equal(int64(42), test.GetNumber())
+	assert.Equal(`native "name"`, test.GetName())
+	assert.Equal(pb.Proto2_LOW, test.GetLevel())
+	assert.Equal([]byte("\x01raw"), test.GetData())
+	assert.Equal("nested", test.GetNested().GetValue())
+	assert.Equal(pb.Proto2_Default_Number, test.GetNumber())
+
+	test = &pb.Proto2{Number: proto.Int64(1)}
+	test.Default()
+	assert.Equal(int64(1), test.GetNumber())
+
+	opts := &descriptorpb.FileOptions{}
+	require.NoError(defaults.ApplyE(opts))
+	assert.Nil(opts.OptimizeFor)
+	require.NoError(defaults.ApplyWithOptions(opts, defaults.WithProto2Defaults()))
+	assert.Equal(descriptorpb.FileOptions_SPEED, *opts.OptimizeFor)
+}
+
+func TestDefaultsEditions(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	generated, reflected := &pb.Editions{}, &pb.Editions{}
+	generated.Default()
+	require.NoError(defaults.ApplyE(reflected))
+	assert.Len(generated.Token, 16)
+	assert.Len(reflected.Token, 16)
+	generated.Token, reflected.Token = nil, nil
+	assert.True(proto.Equal(generated, reflected))
+
+	assert.Equal(int64(42), generated.GetNumber())
+	assert.Equal("name", generated.Name)
+	assert.True(generated.GetFlag())
+	assert.Equal(pb.Editions_HIGH, generated.Level)
+	assert.Equal(uint32(5), generated.GetNative())
+	assert.Equal([]byte("data"), generated.Data)
+	assert.Equal([]byte("secret"), generated.Secret)
+	assert.Equal(0.5, generated.GetNested().Ratio)
+
+	// the explicit presence fields keep their zero values
+	testApply(t, func(t *testing.T, apply func(m *pb.Editions)) {
+		assert := assert2.New(t)
+
+		test := &pb.Editions{Number: proto.Int64(0), Flag: proto.Bool(false), Native: proto.Uint32(0), Data: []byte{}, Token: []byte{}, Secret: []byte{}}
+		apply(test)
+		assert.Equal(int64(0), test.GetNumber())
+		assert.False(test.GetFlag())
+		assert.Equal(uint32(0), test.GetNative())
+		assert.Equal([]byte{}, test.Data)
+		assert.Equal([]byte{}, test.Token)
+		assert.Equal([]byte{}, test.Secret)
+	})
+
+	test := &pb.Editions{Data: []byte{}}
+	assert.False(test.IsDefault())
+	assert.False(defaults.IsDefault(test))
+	test.StripDefaults()
+	assert.NotNil(test.Data)
+
+	// the implicit presence fields cannot be told apart from their zero values
+	test = &pb.Editions{Name: "", Level: pb.Editions_NONE}
+	require.NoError(defaults.ApplyE(test))
+	assert.Equal("name", test.Name)
+	assert.Equal(pb.Editions_HIGH, test.Level)
+}
+
+func TestDefaultsWhen(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *pb.Conditional
+		want *pb.Conditional
+	}{
+		{
+			name: "unset",
+			msg:  &pb.Conditional{},
+			want: &pb.Conditional{Replicas: 1, Address: "localhost"},
+		},
+		{
+			name: "cluster",
+			msg:  &pb.Conditional{Mode: pb.Conditional_CLUSTER},
+			want: &pb.Conditional{Mode: pb.Conditional_CLUSTER, Replicas: 3, Timeout: durationpb.New(30 * time.Second), Address: "localhost"},
+		},
+		{
+			name: "standalone",
+			msg:  &pb.Conditional{Mode: pb.Conditional_STANDALONE},
+			want: &pb.Conditional{Mode: pb.Conditional_STANDALONE, Replicas: 1, Timeout: durationpb.New(5 * time.Second), Address: "localhost"},
+		},
+		{
+			name: "standalone replicas",
+			msg:  &pb.Conditional{Mode: pb.Conditional_STANDALONE, Replicas: 3},
+			want: &pb.Conditional{Mode: pb.Conditional_STANDALONE, Replicas: 3, Timeout: durationpb.New(30 * time.Second), Address: "localhost"},
+		},
+		{
+			name: "presence",
+			msg:  &pb.Conditional{Endpoint: proto.String(""), Peers: []string{"a"}},
+			want: &pb.Conditional{Replicas: 1, Endpoint: proto.String(""), Address: "remote", Peers: []string{"a"}, Token: []byte("peer")},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApplyEqual(t, tt.msg, tt.want)
+		})
+	}
+}
+
+func TestDefaultsExpr(t *testing.T) {
+	assert := assert2.New(t)
+
+	tests := []struct {
+		name string
+		msg  *pb.Expr
+		want *pb.Expr
+	}{
+		{
+			name: "https",
+			msg:  &pb.Expr{Name: "api"},
+			want: &pb.Expr{
+				Name:        "api",
+				DisplayName: "api",
+				Scheme:      "https",
+				Port:        443,
+				Retries:     proto.Int32(6),
+				Timeout:     durationpb.New(443 * time.Millisecond),
+				Limit:       wrapperspb.Int64(4430),
+				Level:       pb.Expr_HIGH,
+				Nested:      &pb.ExprNested{Value: "api"},
+				Ratio:       221.5,
+			},
+		},
+		{
+			name: "http",
+			msg:  &pb.Expr{DisplayName: "Web", Scheme: "http", Retries: proto.Int32(0)},
+			want: &pb.Expr{
+				DisplayName: "Web",
+				Scheme:      "http",
+				Port:        80,
+				Retries:     proto.Int32(0),
+				Timeout:     durationpb.New(80 * time.Millisecond),
+				Limit:       wrapperspb.Int64(800),
+				Level:       pb.Expr_LOW,
+				Nested:      &pb.ExprNested{Value: "Web"},
+				Ratio:       40,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApplyEqual(t, tt.msg, tt.want)
+		})
+	}
+
+	fd := (&pb.Expr{}).ProtoReflect().Descriptor().Fields().ByName("port")
+	assert.Error(expr.Check(fd, "scheme"))
+	assert.Error(expr.Check(fd, "unknown + 1u"))
+	assert.NoError(expr.Check(fd, "uint(size(scheme))"))
+	_, _, err := expr.Value(&pb.Expr{}, fd, "uint(-1)")
+	assert.Error(err)
+}
+
+func TestDefaultsFromField(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	spec := &pb.FromFieldSpec{Name: "spec", Replicas: 3, Data: []byte("data"), Level: pb.FromFieldSpec_HIGH}
+	tests := []struct {
+		name string
+		msg  *pb.FromField
+		want *pb.FromField
+	}{
+		{
+			name: "empty",
+			msg:  &pb.FromField{},
+			want: &pb.FromField{Name: "default", DisplayName: "default"},
+		},
+		{
+			name: "spec",
+			msg:  &pb.FromField{Name: "name", Spec: spec},
+			want: &pb.FromField{
+				DisplayName: "name",
+				Name:        "name",
+				SpecName:    "spec",
+				Replicas:    proto.Int32(3),
+				Data:        []byte("data"),
+				Template:    spec,
+				Spec:        spec,
+				Level:       pb.FromFieldSpec_HIGH,
+			},
+		},
+		{
+			name: "set",
+			msg:  &pb.FromField{DisplayName: "display", Replicas: proto.Int32(0), Spec: spec},
+			want: &pb.FromField{
+				DisplayName: "display",
+				Name:        "default",
+				SpecName:    "spec",
+				Replicas:    proto.Int32(0),
+				Data:        []byte("data"),
+				Template:    spec,
+				Spec:        spec,
+				Level:       pb.FromFieldSpec_HIGH,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApplyEqual(t, tt.msg, tt.want)
+			testApply(t, func(t *testing.T, apply func(m *pb.FromField)) {
+				m := proto.Clone(tt.msg).(*pb.FromField)
+				if apply(m); m.Spec != nil {
+					assert2.NotSame(t, m.Spec, m.Template)
+				}
+			})
+		})
+	}
+
+	md := (&pb.FromField{}).ProtoReflect().Descriptor()
+	order, err := defaults.FieldOrder(md)
+	require.NoError(err)
+	var names []string
+	for _, v := range order {
+		names = append(names, string(v.Name()))
+	}
+	assert.Equal([]string{"name", "display_name", "spec", "spec_name", "replicas", "data", "template", "level"}, names)
+
+	fd := md.Fields().ByName("spec_name")
+	assert.NoError(defaults.CheckFromField(fd, "spec.name"))
+	assert.Error(defaults.CheckFromField(fd, "spec.replicas"))
+	assert.Error(defaults.CheckFromField(fd, "spec.unknown"))
+	assert.Error(defaults.CheckFromField(fd, "spec_name"))
+}
+
+func TestDefaultsFromFieldCycle(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	from := func(path string) *descriptorpb.FieldOptions {
+		opts := &descriptorpb.FieldOptions{}
+		proto.SetExtension(opts, defaults.E_Value, &defaults.FieldDefaults{Type: &defaults.FieldDefaults_FromField{FromField: path}})
+		return opts
+	}
+	field := func(name string, number int32, opts *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+			Options:  opts,
+		}
+	}
+	fd, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("cycle.proto"),
+		Package: proto.String("tests.cycle"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Cycle"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("a", 1, from("b")),
+				field("b", 2, from("c")),
+				field("c", 3, from("a")),
+				field("d", 4, nil),
+			},
+		}},
+	}, protoregistry.GlobalFiles)
+	require.NoError(err)
+	md := fd.Messages().ByName("Cycle")
+	_, err = defaults.FieldOrder(md)
+	require.Error(err)
+	assert.Equal("dependency cycle: a -> b -> c -> a", err.Error())
+
+	m := dynamicpb.NewMessage(md)
+	assert.Error(defaults.ApplyE(m))
+}
+
+func TestDefaultsOrder(t *testing.T) {
+	assert := assert2.New(t)
+	require := require2.New(t)
+
+	tests := []struct {
+		name string
+		msg  *pb.Order
+		want *pb.Order
+	}{
+		{
+			name: "empty",
+			msg:  &pb.Order{},
+			want: &pb.Order{
+				Url:    "https://localhost:443",
+				Port:   443,
+				Mode:   "secure",
+				Host:   "localhost",
+				Scheme: "https",
+				Spec:   &pb.OrderSpec{Host: "localhost"},
+			},
+		},
+		{
+			name: "http",
+			msg:  &pb.Order{Scheme: "http", Spec: &pb.OrderSpec{Host: "example.com"}},
+			want: &pb.Order{
+				Url:    "http://example.com:80",
+				Port:   80,
+				Host:   "example.com",
+				Scheme: "http",
+				Spec:   &pb.OrderSpec{Host: "example.com"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testApplyEqual(t, tt.msg, tt.want)
+		})
+	}
+
+	order, err := defaults.FieldOrder((&pb.Order{}).ProtoReflect().Descriptor())
+	require.NoError(err)
+	var names []string
+	for _, v := range order {
+		names = append(names, string(v.Name()))
+	}
+	assert.Equal([]string{"scheme", "port", "mode", "spec", "host", "url"}, names)
+}
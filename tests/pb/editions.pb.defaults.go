@@ -0,0 +1,302 @@
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package pb
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Editions_Default_Number int64          = 42
+	Editions_Default_Name   string         = "name"
+	Editions_Default_Flag   bool           = true
+	Editions_Default_Level  Editions_Level = 2
+	Editions_Default_Native uint32         = 5
+)
+
+var (
+	Editions_Default_Data = []byte("data")
+)
+
+func (x *Editions) Default() {
+	if x.Number == nil {
+		v := int64(Editions_Default_Number)
+		x.Number = &v
+	}
+	if x.Name == "" {
+		x.Name = Editions_Default_Name
+	}
+	if x.Flag == nil {
+		v := bool(Editions_Default_Flag)
+		x.Flag = &v
+	}
+	if x.Level == 0 {
+		x.Level = Editions_Default_Level
+	}
+	if x.Native == nil {
+		v := uint32(Editions_Default_Native)
+		x.Native = &v
+	}
+	if x.Data == nil {
+		x.Data = append([]byte(nil), Editions_Default_Data...)
+	}
+	if x.Nested == nil {
+		x.Nested = &EditionsNested{}
+	}
+	if v, ok := interface{}(x.Nested).(interface{ Default() }); ok && x.Nested != nil {
+		v.Default()
+	}
+	if x.Token == nil {
+		if v, err := defaults.GenerateBytes(defaults.Generator_UUID_V4, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "token", Err: err})
+		} else {
+			x.Token = v
+		}
+	}
+	if x.Secret == nil {
+		if v, ok, err := defaults.EnvValue((*Editions)(nil).ProtoReflect().Descriptor().Fields().ByNumber(10), "DEFAULTS_TEST_EDITIONS_SECRET:-secret"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "secret", Err: err})
+		} else if ok {
+			x.Secret = v.Bytes()
+		}
+	}
+}
+
+func (x *Editions) DefaultE() error {
+	var errs defaults.Errors
+	if x.Number == nil {
+		v := int64(Editions_Default_Number)
+		x.Number = &v
+	}
+	if x.Name == "" {
+		x.Name = Editions_Default_Name
+	}
+	if x.Flag == nil {
+		v := bool(Editions_Default_Flag)
+		x.Flag = &v
+	}
+	if x.Level == 0 {
+		x.Level = Editions_Default_Level
+	}
+	if x.Native == nil {
+		v := uint32(Editions_Default_Native)
+		x.Native = &v
+	}
+	if x.Data == nil {
+		x.Data = append([]byte(nil), Editions_Default_Data...)
+	}
+	if x.Nested == nil {
+		x.Nested = &EditionsNested{}
+	}
+	if v, ok := interface{}(x.Nested).(interface{ DefaultE() error }); ok && x.Nested != nil {
+		errs.Add("nested", v.DefaultE())
+	} else if v, ok := interface{}(x.Nested).(interface{ Default() }); ok && x.Nested != nil {
+		v.Default()
+	}
+	if x.Token == nil {
+		if v, err := defaults.GenerateBytes(defaults.Generator_UUID_V4, 0); err != nil {
+			errs.Add("token", err)
+		} else {
+			x.Token = v
+		}
+	}
+	if x.Secret == nil {
+		if v, ok, err := defaults.EnvValue((*Editions)(nil).ProtoReflect().Descriptor().Fields().ByNumber(10), "DEFAULTS_TEST_EDITIONS_SECRET:-secret"); err != nil {
+			errs.Add("secret", err)
+		} else if ok {
+			x.Secret = v.Bytes()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Editions) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Number == nil {
+		v := int64(Editions_Default_Number)
+		x.Number = &v
+		paths = append(paths, "number")
+	}
+	if x.Name == "" {
+		x.Name = Editions_Default_Name
+		paths = append(paths, "name")
+	}
+	if x.Flag == nil {
+		v := bool(Editions_Default_Flag)
+		x.Flag = &v
+		paths = append(paths, "flag")
+	}
+	if x.Level == 0 {
+		x.Level = Editions_Default_Level
+		paths = append(paths, "level")
+	}
+	if x.Native == nil {
+		v := uint32(Editions_Default_Native)
+		x.Native = &v
+		paths = append(paths, "native")
+	}
+	if x.Data == nil {
+		x.Data = append([]byte(nil), Editions_Default_Data...)
+		paths = append(paths, "data")
+	}
+	if x.Nested == nil {
+		x.Nested = &EditionsNested{}
+		paths = append(paths, "nested")
+	}
+	if v, ok := interface{}(x.Nested).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Nested != nil {
+		paths = append(paths, defaults.PrefixPaths("nested", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Nested).(interface{ Default() }); ok && x.Nested != nil {
+		v.Default()
+	}
+	if x.Token == nil {
+		if v, err := defaults.GenerateBytes(defaults.Generator_UUID_V4, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "token", Err: err})
+		} else {
+			x.Token = v
+			paths = append(paths, "token")
+		}
+	}
+	if x.Secret == nil {
+		if v, ok, err := defaults.EnvValue((*Editions)(nil).ProtoReflect().Descriptor().Fields().ByNumber(10), "DEFAULTS_TEST_EDITIONS_SECRET:-secret"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "secret", Err: err})
+		} else if ok {
+			x.Secret = v.Bytes()
+			paths = append(paths, "secret")
+		}
+	}
+	return paths
+}
+
+func (x *Editions) StripDefaults() {
+	if s, ok := interface{}(x.Nested).(interface{ StripDefaults() }); ok && x.Nested != nil {
+		s.StripDefaults()
+	}
+	if x.Nested != nil && proto.Size(x.Nested) == 0 {
+		x.Nested = nil
+	}
+	if string(x.Data) == "data" {
+		x.Data = nil
+	}
+	if x.Native != nil && *x.Native == 5 {
+		x.Native = nil
+	}
+	if x.Level == Editions_Level(2) {
+		x.Level = 0
+	}
+	if x.Flag != nil && *x.Flag == true {
+		x.Flag = nil
+	}
+	if x.Name == "name" {
+		x.Name = ""
+	}
+	if x.Number != nil && *x.Number == 42 {
+		x.Number = nil
+	}
+}
+
+func (x *Editions) IsDefault() bool {
+	if x.Number != nil && *x.Number != 42 {
+		return false
+	}
+	if x.Name != "" && x.Name != "name" {
+		return false
+	}
+	if x.Flag != nil && *x.Flag != true {
+		return false
+	}
+	if x.Level != 0 && x.Level != Editions_Level(2) {
+		return false
+	}
+	if x.Native != nil && *x.Native != 5 {
+		return false
+	}
+	if x.Data != nil && string(x.Data) != "data" {
+		return false
+	}
+	if d, ok := interface{}(x.Nested).(interface{ IsDefault() bool }); ok && x.Nested != nil && !d.IsDefault() {
+		return false
+	}
+	return true
+}
+
+func NewEditions() *Editions {
+	x := &Editions{}
+	x.Default()
+	return x
+}
+
+func NewEditionsWith(fn func(x *Editions)) *Editions {
+	x := &Editions{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	EditionsNested_Default_Ratio float64 = 0.5
+)
+
+func (x *EditionsNested) Default() {
+	if x.Ratio == 0 {
+		x.Ratio = EditionsNested_Default_Ratio
+	}
+}
+
+func (x *EditionsNested) DefaultE() error {
+	var errs defaults.Errors
+	if x.Ratio == 0 {
+		x.Ratio = EditionsNested_Default_Ratio
+	}
+	return errs.Err()
+}
+
+func (x *EditionsNested) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Ratio == 0 {
+		x.Ratio = EditionsNested_Default_Ratio
+		paths = append(paths, "ratio")
+	}
+	return paths
+}
+
+func (x *EditionsNested) StripDefaults() {
+	if x.Ratio == 0.5 {
+		x.Ratio = 0
+	}
+}
+
+func (x *EditionsNested) IsDefault() bool {
+	if x.Ratio != 0 && x.Ratio != 0.5 {
+		return false
+	}
+	return true
+}
+
+func NewEditionsNested() *EditionsNested {
+	x := &EditionsNested{}
+	x.Default()
+	return x
+}
+
+func NewEditionsNestedWith(fn func(x *EditionsNested)) *EditionsNested {
+	x := &EditionsNested{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
@@ -0,0 +1,370 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/pb/editions.proto
+
+package pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	_ "go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Editions_Level int32
+
+const (
+	Editions_NONE Editions_Level = 0
+	Editions_LOW  Editions_Level = 1
+	Editions_HIGH Editions_Level = 2
+)
+
+// Enum value maps for Editions_Level.
+var (
+	Editions_Level_name = map[int32]string{
+		0: "NONE",
+		1: "LOW",
+		2: "HIGH",
+	}
+	Editions_Level_value = map[string]int32{
+		"NONE": 0,
+		"LOW":  1,
+		"HIGH": 2,
+	}
+)
+
+func (x Editions_Level) Enum() *Editions_Level {
+	p := new(Editions_Level)
+	*p = x
+	return p
+}
+
+func (x Editions_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Editions_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_pb_editions_proto_enumTypes[0].Descriptor()
+}
+
+func (Editions_Level) Type() protoreflect.EnumType {
+	return &file_tests_pb_editions_proto_enumTypes[0]
+}
+
+func (x Editions_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Editions_Level.Descriptor instead.
+func (Editions_Level) EnumDescriptor() ([]byte, []int) {
+	return file_tests_pb_editions_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Editions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number *int64          `protobuf:"varint,1,opt,name=number" json:"number,omitempty"`
+	Name   string          `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	Flag   *bool           `protobuf:"varint,3,req,name=flag" json:"flag,omitempty"`
+	Level  Editions_Level  `protobuf:"varint,4,opt,name=level,enum=tests.Editions_Level" json:"level,omitempty"`
+	Native *uint32         `protobuf:"varint,5,opt,name=native,def=5" json:"native,omitempty"`
+	Data   []byte          `protobuf:"bytes,6,opt,name=data" json:"data,omitempty"`
+	Values []string        `protobuf:"bytes,7,rep,name=values" json:"values,omitempty"`
+	Nested *EditionsNested `protobuf:"bytes,8,opt,name=nested" json:"nested,omitempty"`
+	Token  []byte          `protobuf:"bytes,9,opt,name=token" json:"token,omitempty"`
+	Secret []byte          `protobuf:"bytes,10,opt,name=secret" json:"secret,omitempty"`
+}
+
+// Default values for Editions fields.
+const (
+	Default_Editions_Native = uint32(5)
+)
+
+func (x *Editions) Reset() {
+	*x = Editions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_editions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Editions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Editions) ProtoMessage() {}
+
+func (x *Editions) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_editions_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Editions.ProtoReflect.Descriptor instead.
+func (*Editions) Descriptor() ([]byte, []int) {
+	return file_tests_pb_editions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Editions) GetNumber() int64 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return 0
+}
+
+func (x *Editions) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Editions) GetFlag() bool {
+	if x != nil && x.Flag != nil {
+		return *x.Flag
+	}
+	return false
+}
+
+func (x *Editions) GetLevel() Editions_Level {
+	if x != nil {
+		return x.Level
+	}
+	return Editions_NONE
+}
+
+func (x *Editions) GetNative() uint32 {
+	if x != nil && x.Native != nil {
+		return *x.Native
+	}
+	return Default_Editions_Native
+}
+
+func (x *Editions) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Editions) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *Editions) GetNested() *EditionsNested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Editions) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Editions) GetSecret() []byte {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+type EditionsNested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ratio float64 `protobuf:"fixed64,1,opt,name=ratio" json:"ratio,omitempty"`
+}
+
+func (x *EditionsNested) Reset() {
+	*x = EditionsNested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_editions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditionsNested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditionsNested) ProtoMessage() {}
+
+func (x *EditionsNested) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_editions_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditionsNested.ProtoReflect.Descriptor instead.
+func (*EditionsNested) Descriptor() ([]byte, []int) {
+	return file_tests_pb_editions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EditionsNested) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+var File_tests_pb_editions_proto protoreflect.FileDescriptor
+
+var file_tests_pb_editions_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x1a, 0x17, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x03, 0x0a, 0x08, 0x45, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x20, 0x2a, 0x52, 0x06, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0e, 0x9a, 0x49, 0x06, 0x72, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0xaa, 0x01,
+	0x02, 0x08, 0x02, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x66, 0x6c, 0x61,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0a, 0x9a, 0x49, 0x02, 0x68, 0x01, 0xaa, 0x01,
+	0x02, 0x08, 0x03, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x38, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42,
+	0x0b, 0x9a, 0x49, 0x03, 0x80, 0x01, 0x02, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x05, 0x6c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x19, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x3a, 0x01, 0x35, 0x52, 0x06, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1d,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0x9a, 0x49,
+	0x06, 0x7a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42, 0x0a, 0x9a, 0x49,
+	0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x01, 0x52, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x12, 0x23, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x0d, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x01, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x30, 0x9a, 0x49, 0x28, 0xd2, 0x01, 0x25, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x53, 0x5f, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x3a, 0x2d, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0xaa, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0x24, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45,
+	0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48,
+	0x49, 0x47, 0x48, 0x10, 0x02, 0x22, 0x39, 0x0a, 0x0e, 0x45, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x11, 0x9a, 0x49, 0x09, 0x11, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0xe0, 0x3f, 0xaa, 0x01, 0x02, 0x08, 0x02, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x42, 0x30, 0x98, 0x49, 0x01, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x2e,
+	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e,
+	0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f,
+	0x70, 0x62, 0x62, 0x08, 0x65, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x70, 0xe8, 0x07,
+}
+
+var (
+	file_tests_pb_editions_proto_rawDescOnce sync.Once
+	file_tests_pb_editions_proto_rawDescData = file_tests_pb_editions_proto_rawDesc
+)
+
+func file_tests_pb_editions_proto_rawDescGZIP() []byte {
+	file_tests_pb_editions_proto_rawDescOnce.Do(func() {
+		file_tests_pb_editions_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_editions_proto_rawDescData)
+	})
+	return file_tests_pb_editions_proto_rawDescData
+}
+
+var file_tests_pb_editions_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_pb_editions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_pb_editions_proto_goTypes = []interface{}{
+	(Editions_Level)(0),    // 0: tests.Editions.Level
+	(*Editions)(nil),       // 1: tests.Editions
+	(*EditionsNested)(nil), // 2: tests.EditionsNested
+}
+var file_tests_pb_editions_proto_depIdxs = []int32{
+	0, // 0: tests.Editions.level:type_name -> tests.Editions.Level
+	2, // 1: tests.Editions.nested:type_name -> tests.EditionsNested
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_editions_proto_init() }
+func file_tests_pb_editions_proto_init() {
+	if File_tests_pb_editions_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_editions_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Editions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_editions_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EditionsNested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_pb_editions_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_pb_editions_proto_goTypes,
+		DependencyIndexes: file_tests_pb_editions_proto_depIdxs,
+		EnumInfos:         file_tests_pb_editions_proto_enumTypes,
+		MessageInfos:      file_tests_pb_editions_proto_msgTypes,
+	}.Build()
+	File_tests_pb_editions_proto = out.File
+	file_tests_pb_editions_proto_rawDesc = nil
+	file_tests_pb_editions_proto_goTypes = nil
+	file_tests_pb_editions_proto_depIdxs = nil
+}
@@ -0,0 +1,300 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package pb
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+	"go.linka.cloud/protoc-gen-defaults/defaults/expr"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Expr_Default_Scheme string = "https"
+)
+
+func (x *Expr) Default() {
+	if x.DisplayName == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(2), "name"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "display_name", Err: err})
+		} else if ok {
+			x.DisplayName = v.String()
+		}
+	}
+	if x.Scheme == "" {
+		x.Scheme = Expr_Default_Scheme
+	}
+	if x.Port == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(4), "scheme == 'https' ? 443u : 80u"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "port", Err: err})
+		} else if ok {
+			x.Port = uint32(v.Uint())
+		}
+	}
+	if x.Retries == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(5), "size(name) * 2"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "retries", Err: err})
+		} else if ok {
+			x.Retries = func(v int32) *int32 { return &v }(int32(v.Int()))
+		}
+	}
+	if x.Timeout == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(6), "duration(string(port) + 'ms')"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "timeout", Err: err})
+		} else if ok {
+			x.Timeout = v.Message().Interface().(*durationpb.Duration)
+		}
+	}
+	if x.Limit == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(7), "int(port) * 10"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "limit", Err: err})
+		} else if ok {
+			x.Limit = v.Message().Interface().(*wrapperspb.Int64Value)
+		}
+	}
+	if x.Level == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(8), "port == 443u ? 2 : 1"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "level", Err: err})
+		} else if ok {
+			x.Level = Expr_Level(v.Enum())
+		}
+	}
+	if x.Nested == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(9), "tests.ExprNested{value: display_name}"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "nested", Err: err})
+		} else if ok {
+			x.Nested = v.Message().Interface().(*ExprNested)
+		}
+	}
+	if x.Ratio == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(10), "double(port) / 2.0"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "ratio", Err: err})
+		} else if ok {
+			x.Ratio = v.Float()
+		}
+	}
+}
+
+func (x *Expr) DefaultE() error {
+	var errs defaults.Errors
+	if x.DisplayName == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(2), "name"); err != nil {
+			errs.Add("display_name", err)
+		} else if ok {
+			x.DisplayName = v.String()
+		}
+	}
+	if x.Scheme == "" {
+		x.Scheme = Expr_Default_Scheme
+	}
+	if x.Port == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(4), "scheme == 'https' ? 443u : 80u"); err != nil {
+			errs.Add("port", err)
+		} else if ok {
+			x.Port = uint32(v.Uint())
+		}
+	}
+	if x.Retries == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(5), "size(name) * 2"); err != nil {
+			errs.Add("retries", err)
+		} else if ok {
+			x.Retries = func(v int32) *int32 { return &v }(int32(v.Int()))
+		}
+	}
+	if x.Timeout == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(6), "duration(string(port) + 'ms')"); err != nil {
+			errs.Add("timeout", err)
+		} else if ok {
+			x.Timeout = v.Message().Interface().(*durationpb.Duration)
+		}
+	}
+	if x.Limit == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(7), "int(port) * 10"); err != nil {
+			errs.Add("limit", err)
+		} else if ok {
+			x.Limit = v.Message().Interface().(*wrapperspb.Int64Value)
+		}
+	}
+	if x.Level == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(8), "port == 443u ? 2 : 1"); err != nil {
+			errs.Add("level", err)
+		} else if ok {
+			x.Level = Expr_Level(v.Enum())
+		}
+	}
+	if x.Nested == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(9), "tests.ExprNested{value: display_name}"); err != nil {
+			errs.Add("nested", err)
+		} else if ok {
+			x.Nested = v.Message().Interface().(*ExprNested)
+		}
+	}
+	if x.Ratio == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(10), "double(port) / 2.0"); err != nil {
+			errs.Add("ratio", err)
+		} else if ok {
+			x.Ratio = v.Float()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Expr) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.DisplayName == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(2), "name"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "display_name", Err: err})
+		} else if ok {
+			x.DisplayName = v.String()
+			paths = append(paths, "display_name")
+		}
+	}
+	if x.Scheme == "" {
+		x.Scheme = Expr_Default_Scheme
+		paths = append(paths, "scheme")
+	}
+	if x.Port == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(4), "scheme == 'https' ? 443u : 80u"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "port", Err: err})
+		} else if ok {
+			x.Port = uint32(v.Uint())
+			paths = append(paths, "port")
+		}
+	}
+	if x.Retries == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(5), "size(name) * 2"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "retries", Err: err})
+		} else if ok {
+			x.Retries = func(v int32) *int32 { return &v }(int32(v.Int()))
+			paths = append(paths, "retries")
+		}
+	}
+	if x.Timeout == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(6), "duration(string(port) + 'ms')"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "timeout", Err: err})
+		} else if ok {
+			x.Timeout = v.Message().Interface().(*durationpb.Duration)
+			paths = append(paths, "timeout")
+		}
+	}
+	if x.Limit == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(7), "int(port) * 10"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "limit", Err: err})
+		} else if ok {
+			x.Limit = v.Message().Interface().(*wrapperspb.Int64Value)
+			paths = append(paths, "limit")
+		}
+	}
+	if x.Level == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(8), "port == 443u ? 2 : 1"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "level", Err: err})
+		} else if ok {
+			x.Level = Expr_Level(v.Enum())
+			paths = append(paths, "level")
+		}
+	}
+	if x.Nested == nil {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(9), "tests.ExprNested{value: display_name}"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "nested", Err: err})
+		} else if ok {
+			x.Nested = v.Message().Interface().(*ExprNested)
+			paths = append(paths, "nested")
+		}
+	}
+	if x.Ratio == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(10), "double(port) / 2.0"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "ratio", Err: err})
+		} else if ok {
+			x.Ratio = v.Float()
+			paths = append(paths, "ratio")
+		}
+	}
+	return paths
+}
+
+func (x *Expr) StripDefaults() {
+	if x.Scheme == "https" {
+		x.Scheme = ""
+	}
+}
+
+func (x *Expr) IsDefault() bool {
+	if x.Scheme != "" && x.Scheme != "https" {
+		return false
+	}
+	return true
+}
+
+func NewExpr() *Expr {
+	x := &Expr{}
+	x.Default()
+	return x
+}
+
+func NewExprWith(fn func(x *Expr)) *Expr {
+	x := &Expr{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *ExprNested) Default() {
+}
+
+func (x *ExprNested) DefaultE() error {
+	var errs defaults.Errors
+	return errs.Err()
+}
+
+func (x *ExprNested) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	return paths
+}
+
+func (x *ExprNested) StripDefaults() {
+}
+
+func (x *ExprNested) IsDefault() bool {
+	return true
+}
+
+func NewExprNested() *ExprNested {
+	x := &ExprNested{}
+	x.Default()
+	return x
+}
+
+func NewExprNestedWith(fn func(x *ExprNested)) *ExprNested {
+	x := &ExprNested{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
@@ -0,0 +1,400 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/pb/expr.proto
+
+package pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+
+	_ "go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Expr_Level int32
+
+const (
+	Expr_NONE Expr_Level = 0
+	Expr_LOW  Expr_Level = 1
+	Expr_HIGH Expr_Level = 2
+)
+
+// Enum value maps for Expr_Level.
+var (
+	Expr_Level_name = map[int32]string{
+		0: "NONE",
+		1: "LOW",
+		2: "HIGH",
+	}
+	Expr_Level_value = map[string]int32{
+		"NONE": 0,
+		"LOW":  1,
+		"HIGH": 2,
+	}
+)
+
+func (x Expr_Level) Enum() *Expr_Level {
+	p := new(Expr_Level)
+	*p = x
+	return p
+}
+
+func (x Expr_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Expr_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_pb_expr_proto_enumTypes[0].Descriptor()
+}
+
+func (Expr_Level) Type() protoreflect.EnumType {
+	return &file_tests_pb_expr_proto_enumTypes[0]
+}
+
+func (x Expr_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Expr_Level.Descriptor instead.
+func (Expr_Level) EnumDescriptor() ([]byte, []int) {
+	return file_tests_pb_expr_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Expr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	DisplayName string                 `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Scheme      string                 `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Port        uint32                 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	Retries     *int32                 `protobuf:"varint,5,opt,name=retries,proto3,oneof" json:"retries,omitempty"`
+	Timeout     *durationpb.Duration   `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Limit       *wrapperspb.Int64Value `protobuf:"bytes,7,opt,name=limit,proto3" json:"limit,omitempty"`
+	Level       Expr_Level             `protobuf:"varint,8,opt,name=level,proto3,enum=tests.Expr_Level" json:"level,omitempty"`
+	Nested      *ExprNested            `protobuf:"bytes,9,opt,name=nested,proto3" json:"nested,omitempty"`
+	Ratio       float64                `protobuf:"fixed64,10,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (x *Expr) Reset() {
+	*x = Expr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_expr_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Expr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Expr) ProtoMessage() {}
+
+func (x *Expr) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_expr_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Expr.ProtoReflect.Descriptor instead.
+func (*Expr) Descriptor() ([]byte, []int) {
+	return file_tests_pb_expr_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Expr) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Expr) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Expr) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *Expr) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Expr) GetRetries() int32 {
+	if x != nil && x.Retries != nil {
+		return *x.Retries
+	}
+	return 0
+}
+
+func (x *Expr) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Expr) GetLimit() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+func (x *Expr) GetLevel() Expr_Level {
+	if x != nil {
+		return x.Level
+	}
+	return Expr_NONE
+}
+
+func (x *Expr) GetNested() *ExprNested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Expr) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+type ExprNested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ExprNested) Reset() {
+	*x = ExprNested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_expr_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprNested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprNested) ProtoMessage() {}
+
+func (x *ExprNested) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_expr_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprNested.ProtoReflect.Descriptor instead.
+func (*ExprNested) Descriptor() ([]byte, []int) {
+	return file_tests_pb_expr_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExprNested) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_tests_pb_expr_proto protoreflect.FileDescriptor
+
+var file_tests_pb_expr_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x78, 0x70, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xfe, 0x04, 0x0a, 0x04, 0x45, 0x78, 0x70, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0xda, 0x01, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x72, 0x05, 0x68, 0x74, 0x74, 0x70, 0x73, 0x52, 0x06, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x24, 0x9a, 0x49, 0x21, 0xda, 0x01, 0x1e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x68, 0x74, 0x74, 0x70, 0x73, 0x27, 0x20, 0x3f, 0x20, 0x34,
+	0x34, 0x33, 0x75, 0x20, 0x3a, 0x20, 0x38, 0x30, 0x75, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x33, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x14, 0x9a, 0x49, 0x11, 0xda, 0x01, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x6e, 0x61, 0x6d,
+	0x65, 0x29, 0x20, 0x2a, 0x20, 0x32, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x58, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x23, 0x9a, 0x49, 0x20, 0xda, 0x01, 0x1d, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x28, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x70, 0x6f, 0x72, 0x74, 0x29, 0x20, 0x2b, 0x20,
+	0x27, 0x6d, 0x73, 0x27, 0x29, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x47,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x14, 0x9a, 0x49, 0x11, 0xda,
+	0x01, 0x0e, 0x69, 0x6e, 0x74, 0x28, 0x70, 0x6f, 0x72, 0x74, 0x29, 0x20, 0x2a, 0x20, 0x31, 0x30,
+	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x43, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45,
+	0x78, 0x70, 0x72, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x1a, 0x9a, 0x49, 0x17, 0xda, 0x01,
+	0x14, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3d, 0x3d, 0x20, 0x34, 0x34, 0x33, 0x75, 0x20, 0x3f, 0x20,
+	0x32, 0x20, 0x3a, 0x20, 0x31, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x56, 0x0a, 0x06,
+	0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x42,
+	0x2b, 0x9a, 0x49, 0x28, 0xda, 0x01, 0x25, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x78, 0x70,
+	0x72, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x7b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x20, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x52, 0x06, 0x6e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x18, 0x9a, 0x49, 0x15, 0xda, 0x01, 0x12, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x28, 0x70, 0x6f, 0x72, 0x74, 0x29, 0x20, 0x2f, 0x20, 0x32, 0x2e, 0x30, 0x52, 0x05, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x22, 0x24, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a,
+	0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x02, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x4e, 0x65,
+	0x73, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x6f,
+	0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_tests_pb_expr_proto_rawDescOnce sync.Once
+	file_tests_pb_expr_proto_rawDescData = file_tests_pb_expr_proto_rawDesc
+)
+
+func file_tests_pb_expr_proto_rawDescGZIP() []byte {
+	file_tests_pb_expr_proto_rawDescOnce.Do(func() {
+		file_tests_pb_expr_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_expr_proto_rawDescData)
+	})
+	return file_tests_pb_expr_proto_rawDescData
+}
+
+var file_tests_pb_expr_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_pb_expr_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_pb_expr_proto_goTypes = []interface{}{
+	(Expr_Level)(0),               // 0: tests.Expr.Level
+	(*Expr)(nil),                  // 1: tests.Expr
+	(*ExprNested)(nil),            // 2: tests.ExprNested
+	(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+	(*wrapperspb.Int64Value)(nil), // 4: google.protobuf.Int64Value
+}
+var file_tests_pb_expr_proto_depIdxs = []int32{
+	3, // 0: tests.Expr.timeout:type_name -> google.protobuf.Duration
+	4, // 1: tests.Expr.limit:type_name -> google.protobuf.Int64Value
+	0, // 2: tests.Expr.level:type_name -> tests.Expr.Level
+	2, // 3: tests.Expr.nested:type_name -> tests.ExprNested
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_expr_proto_init() }
+func file_tests_pb_expr_proto_init() {
+	if File_tests_pb_expr_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_expr_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Expr); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_expr_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprNested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_pb_expr_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_pb_expr_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_pb_expr_proto_goTypes,
+		DependencyIndexes: file_tests_pb_expr_proto_depIdxs,
+		EnumInfos:         file_tests_pb_expr_proto_enumTypes,
+		MessageInfos:      file_tests_pb_expr_proto_msgTypes,
+	}.Build()
+	File_tests_pb_expr_proto = out.File
+	file_tests_pb_expr_proto_rawDesc = nil
+	file_tests_pb_expr_proto_goTypes = nil
+	file_tests_pb_expr_proto_depIdxs = nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package pb
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	FromField_Default_Name string = "default"
+)
+
+func (x *FromField) Default() {
+	if x.Name == "" {
+		x.Name = FromField_Default_Name
+	}
+	if x.DisplayName == "" {
+		if x.Name != "" {
+			x.DisplayName = x.GetName()
+		}
+	}
+	if x.SpecName == "" {
+		if p := x.GetSpec(); p != nil && p.Name != "" {
+			x.SpecName = p.GetName()
+		}
+	}
+	if x.Replicas == nil {
+		if p := x.GetSpec(); p != nil && p.Replicas != 0 {
+			x.Replicas = func(v int32) *int32 { return &v }(p.GetReplicas())
+		}
+	}
+	if len(x.Data) == 0 {
+		if p := x.GetSpec(); p != nil && len(p.Data) != 0 {
+			x.Data = append([]byte(nil), p.GetData()...)
+		}
+	}
+	if x.Template == nil {
+		if x.Spec != nil {
+			x.Template = proto.Clone(x.GetSpec()).(*FromFieldSpec)
+		}
+	}
+	if x.Level == 0 {
+		if p := x.GetSpec(); p != nil && p.Level != 0 {
+			x.Level = p.GetLevel()
+		}
+	}
+}
+
+func (x *FromField) DefaultE() error {
+	var errs defaults.Errors
+	if x.Name == "" {
+		x.Name = FromField_Default_Name
+	}
+	if x.DisplayName == "" {
+		if x.Name != "" {
+			x.DisplayName = x.GetName()
+		}
+	}
+	if x.SpecName == "" {
+		if p := x.GetSpec(); p != nil && p.Name != "" {
+			x.SpecName = p.GetName()
+		}
+	}
+	if x.Replicas == nil {
+		if p := x.GetSpec(); p != nil && p.Replicas != 0 {
+			x.Replicas = func(v int32) *int32 { return &v }(p.GetReplicas())
+		}
+	}
+	if len(x.Data) == 0 {
+		if p := x.GetSpec(); p != nil && len(p.Data) != 0 {
+			x.Data = append([]byte(nil), p.GetData()...)
+		}
+	}
+	if x.Template == nil {
+		if x.Spec != nil {
+			x.Template = proto.Clone(x.GetSpec()).(*FromFieldSpec)
+		}
+	}
+	if x.Level == 0 {
+		if p := x.GetSpec(); p != nil && p.Level != 0 {
+			x.Level = p.GetLevel()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *FromField) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Name == "" {
+		x.Name = FromField_Default_Name
+		paths = append(paths, "name")
+	}
+	if x.DisplayName == "" {
+		if x.Name != "" {
+			x.DisplayName = x.GetName()
+			paths = append(paths, "display_name")
+		}
+	}
+	if x.SpecName == "" {
+		if p := x.GetSpec(); p != nil && p.Name != "" {
+			x.SpecName = p.GetName()
+			paths = append(paths, "spec_name")
+		}
+	}
+	if x.Replicas == nil {
+		if p := x.GetSpec(); p != nil && p.Replicas != 0 {
+			x.Replicas = func(v int32) *int32 { return &v }(p.GetReplicas())
+			paths = append(paths, "replicas")
+		}
+	}
+	if len(x.Data) == 0 {
+		if p := x.GetSpec(); p != nil && len(p.Data) != 0 {
+			x.Data = append([]byte(nil), p.GetData()...)
+			paths = append(paths, "data")
+		}
+	}
+	if x.Template == nil {
+		if x.Spec != nil {
+			x.Template = proto.Clone(x.GetSpec()).(*FromFieldSpec)
+			paths = append(paths, "template")
+		}
+	}
+	if x.Level == 0 {
+		if p := x.GetSpec(); p != nil && p.Level != 0 {
+			x.Level = p.GetLevel()
+			paths = append(paths, "level")
+		}
+	}
+	return paths
+}
+
+func (x *FromField) StripDefaults() {
+	if x.Name == "default" {
+		x.Name = ""
+	}
+}
+
+func (x *FromField) IsDefault() bool {
+	if x.Name != "" && x.Name != "default" {
+		return false
+	}
+	return true
+}
+
+func NewFromField() *FromField {
+	x := &FromField{}
+	x.Default()
+	return x
+}
+
+func NewFromFieldWith(fn func(x *FromField)) *FromField {
+	x := &FromField{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *FromFieldSpec) Default() {
+}
+
+func (x *FromFieldSpec) DefaultE() error {
+	var errs defaults.Errors
+	return errs.Err()
+}
+
+func (x *FromFieldSpec) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	return paths
+}
+
+func (x *FromFieldSpec) StripDefaults() {
+}
+
+func (x *FromFieldSpec) IsDefault() bool {
+	return true
+}
+
+func NewFromFieldSpec() *FromFieldSpec {
+	x := &FromFieldSpec{}
+	x.Default()
+	return x
+}
+
+func NewFromFieldSpecWith(fn func(x *FromFieldSpec)) *FromFieldSpec {
+	x := &FromFieldSpec{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
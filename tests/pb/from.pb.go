@@ -0,0 +1,394 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/pb/from.proto
+
+package pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	_ "go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FromFieldSpec_Level int32
+
+const (
+	FromFieldSpec_NONE FromFieldSpec_Level = 0
+	FromFieldSpec_LOW  FromFieldSpec_Level = 1
+	FromFieldSpec_HIGH FromFieldSpec_Level = 2
+)
+
+// Enum value maps for FromFieldSpec_Level.
+var (
+	FromFieldSpec_Level_name = map[int32]string{
+		0: "NONE",
+		1: "LOW",
+		2: "HIGH",
+	}
+	FromFieldSpec_Level_value = map[string]int32{
+		"NONE": 0,
+		"LOW":  1,
+		"HIGH": 2,
+	}
+)
+
+func (x FromFieldSpec_Level) Enum() *FromFieldSpec_Level {
+	p := new(FromFieldSpec_Level)
+	*p = x
+	return p
+}
+
+func (x FromFieldSpec_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FromFieldSpec_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_pb_from_proto_enumTypes[0].Descriptor()
+}
+
+func (FromFieldSpec_Level) Type() protoreflect.EnumType {
+	return &file_tests_pb_from_proto_enumTypes[0]
+}
+
+func (x FromFieldSpec_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FromFieldSpec_Level.Descriptor instead.
+func (FromFieldSpec_Level) EnumDescriptor() ([]byte, []int) {
+	return file_tests_pb_from_proto_rawDescGZIP(), []int{1, 0}
+}
+
+type FromField struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DisplayName string              `protobuf:"bytes,1,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Name        string              `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SpecName    string              `protobuf:"bytes,3,opt,name=spec_name,json=specName,proto3" json:"spec_name,omitempty"`
+	Replicas    *int32              `protobuf:"varint,4,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"`
+	Data        []byte              `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Template    *FromFieldSpec      `protobuf:"bytes,6,opt,name=template,proto3" json:"template,omitempty"`
+	Spec        *FromFieldSpec      `protobuf:"bytes,7,opt,name=spec,proto3" json:"spec,omitempty"`
+	Level       FromFieldSpec_Level `protobuf:"varint,8,opt,name=level,proto3,enum=tests.FromFieldSpec_Level" json:"level,omitempty"`
+}
+
+func (x *FromField) Reset() {
+	*x = FromField{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_from_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FromField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FromField) ProtoMessage() {}
+
+func (x *FromField) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_from_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FromField.ProtoReflect.Descriptor instead.
+func (*FromField) Descriptor() ([]byte, []int) {
+	return file_tests_pb_from_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FromField) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *FromField) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FromField) GetSpecName() string {
+	if x != nil {
+		return x.SpecName
+	}
+	return ""
+}
+
+func (x *FromField) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *FromField) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FromField) GetTemplate() *FromFieldSpec {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *FromField) GetSpec() *FromFieldSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *FromField) GetLevel() FromFieldSpec_Level {
+	if x != nil {
+		return x.Level
+	}
+	return FromFieldSpec_NONE
+}
+
+type FromFieldSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replicas int32               `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Data     []byte              `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Level    FromFieldSpec_Level `protobuf:"varint,4,opt,name=level,proto3,enum=tests.FromFieldSpec_Level" json:"level,omitempty"`
+}
+
+func (x *FromFieldSpec) Reset() {
+	*x = FromFieldSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_from_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FromFieldSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FromFieldSpec) ProtoMessage() {}
+
+func (x *FromFieldSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_from_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FromFieldSpec.ProtoReflect.Descriptor instead.
+func (*FromFieldSpec) Descriptor() ([]byte, []int) {
+	return file_tests_pb_from_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FromFieldSpec) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FromFieldSpec) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *FromFieldSpec) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FromFieldSpec) GetLevel() FromFieldSpec_Level {
+	if x != nil {
+		return x.Level
+	}
+	return FromFieldSpec_NONE
+}
+
+var File_tests_pb_from_proto protoreflect.FileDescriptor
+
+var file_tests_pb_from_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x66, 0x72, 0x6f, 0x6d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9e, 0x03, 0x0a, 0x09, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x2d, 0x0a, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0xe2, 0x01,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0x9a, 0x49, 0x09, 0x72, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0x9a, 0x49, 0x0c, 0xe2, 0x01, 0x09, 0x73,
+	0x70, 0x65, 0x63, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x73, 0x70, 0x65, 0x63, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0x9a, 0x49, 0x10, 0xe2, 0x01, 0x0d, 0x73, 0x70, 0x65, 0x63,
+	0x2e, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x73, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0f, 0x9a, 0x49, 0x0c, 0xe2, 0x01, 0x09, 0x73, 0x70,
+	0x65, 0x63, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a,
+	0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x53, 0x70, 0x65, 0x63, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0xe2, 0x01, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x73,
+	0x70, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x52,
+	0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x42, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x6f,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x42, 0x10, 0x9a, 0x49, 0x0d, 0xe2, 0x01, 0x0a, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x0d, 0x46, 0x72, 0x6f, 0x6d, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x30, 0x0a, 0x05,
+	0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x70, 0x65,
+	0x63, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x24,
+	0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10,
+	0x00, 0x12, 0x07, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x49,
+	0x47, 0x48, 0x10, 0x02, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_pb_from_proto_rawDescOnce sync.Once
+	file_tests_pb_from_proto_rawDescData = file_tests_pb_from_proto_rawDesc
+)
+
+func file_tests_pb_from_proto_rawDescGZIP() []byte {
+	file_tests_pb_from_proto_rawDescOnce.Do(func() {
+		file_tests_pb_from_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_from_proto_rawDescData)
+	})
+	return file_tests_pb_from_proto_rawDescData
+}
+
+var file_tests_pb_from_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_pb_from_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_pb_from_proto_goTypes = []interface{}{
+	(FromFieldSpec_Level)(0), // 0: tests.FromFieldSpec.Level
+	(*FromField)(nil),        // 1: tests.FromField
+	(*FromFieldSpec)(nil),    // 2: tests.FromFieldSpec
+}
+var file_tests_pb_from_proto_depIdxs = []int32{
+	2, // 0: tests.FromField.template:type_name -> tests.FromFieldSpec
+	2, // 1: tests.FromField.spec:type_name -> tests.FromFieldSpec
+	0, // 2: tests.FromField.level:type_name -> tests.FromFieldSpec.Level
+	0, // 3: tests.FromFieldSpec.level:type_name -> tests.FromFieldSpec.Level
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_from_proto_init() }
+func file_tests_pb_from_proto_init() {
+	if File_tests_pb_from_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_from_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FromField); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_from_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FromFieldSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_pb_from_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_pb_from_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_pb_from_proto_goTypes,
+		DependencyIndexes: file_tests_pb_from_proto_depIdxs,
+		EnumInfos:         file_tests_pb_from_proto_enumTypes,
+		MessageInfos:      file_tests_pb_from_proto_msgTypes,
+	}.Build()
+	File_tests_pb_from_proto = out.File
+	file_tests_pb_from_proto_rawDesc = nil
+	file_tests_pb_from_proto_goTypes = nil
+	file_tests_pb_from_proto_depIdxs = nil
+}
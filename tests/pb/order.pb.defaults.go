@@ -0,0 +1,268 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package pb
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+	"go.linka.cloud/protoc-gen-defaults/defaults/expr"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Order_Default_Scheme string = "https"
+)
+
+func (x *Order) Default() {
+	if x.Scheme == "" {
+		x.Scheme = Order_Default_Scheme
+	}
+	if x.Port == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(2), "scheme == 'https' ? 443u : 80u"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "port", Err: err})
+		} else if ok {
+			x.Port = uint32(v.Uint())
+		}
+	}
+	if x.GetScheme() == "https" {
+		if x.Mode == "" {
+			x.Mode = "secure"
+		}
+	}
+	if x.Spec == nil {
+		x.Spec = &OrderSpec{}
+	}
+	if v, ok := interface{}(x.Spec).(interface{ Default() }); ok && x.Spec != nil {
+		v.Default()
+	}
+	if x.Host == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(4), "spec.host"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "host", Err: err})
+		} else if ok {
+			x.Host = v.String()
+		}
+	}
+	if x.Url == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(1), "scheme + '://' + host + ':' + string(port)"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "url", Err: err})
+		} else if ok {
+			x.Url = v.String()
+		}
+	}
+}
+
+func (x *Order) DefaultE() error {
+	var errs defaults.Errors
+	if x.Scheme == "" {
+		x.Scheme = Order_Default_Scheme
+	}
+	if x.Port == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(2), "scheme == 'https' ? 443u : 80u"); err != nil {
+			errs.Add("port", err)
+		} else if ok {
+			x.Port = uint32(v.Uint())
+		}
+	}
+	if x.GetScheme() == "https" {
+		if x.Mode == "" {
+			x.Mode = "secure"
+		}
+	}
+	if x.Spec == nil {
+		x.Spec = &OrderSpec{}
+	}
+	if v, ok := interface{}(x.Spec).(interface{ DefaultE() error }); ok && x.Spec != nil {
+		errs.Add("spec", v.DefaultE())
+	} else if v, ok := interface{}(x.Spec).(interface{ Default() }); ok && x.Spec != nil {
+		v.Default()
+	}
+	if x.Host == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(4), "spec.host"); err != nil {
+			errs.Add("host", err)
+		} else if ok {
+			x.Host = v.String()
+		}
+	}
+	if x.Url == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(1), "scheme + '://' + host + ':' + string(port)"); err != nil {
+			errs.Add("url", err)
+		} else if ok {
+			x.Url = v.String()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Order) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Scheme == "" {
+		x.Scheme = Order_Default_Scheme
+		paths = append(paths, "scheme")
+	}
+	if x.Port == 0 {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(2), "scheme == 'https' ? 443u : 80u"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "port", Err: err})
+		} else if ok {
+			x.Port = uint32(v.Uint())
+			paths = append(paths, "port")
+		}
+	}
+	if x.GetScheme() == "https" {
+		if x.Mode == "" {
+			x.Mode = "secure"
+			paths = append(paths, "mode")
+		}
+	}
+	if x.Spec == nil {
+		x.Spec = &OrderSpec{}
+		paths = append(paths, "spec")
+	}
+	if v, ok := interface{}(x.Spec).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Spec != nil {
+		paths = append(paths, defaults.PrefixPaths("spec", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Spec).(interface{ Default() }); ok && x.Spec != nil {
+		v.Default()
+	}
+	if x.Host == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(4), "spec.host"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "host", Err: err})
+		} else if ok {
+			x.Host = v.String()
+			paths = append(paths, "host")
+		}
+	}
+	if x.Url == "" {
+		if v, ok, err := expr.Value(x, x.ProtoReflect().Descriptor().Fields().ByNumber(1), "scheme + '://' + host + ':' + string(port)"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "url", Err: err})
+		} else if ok {
+			x.Url = v.String()
+			paths = append(paths, "url")
+		}
+	}
+	return paths
+}
+
+func (x *Order) StripDefaults() {
+	if s, ok := interface{}(x.Spec).(interface{ StripDefaults() }); ok && x.Spec != nil {
+		s.StripDefaults()
+	}
+	if x.Spec != nil && proto.Size(x.Spec) == 0 {
+		x.Spec = nil
+	}
+	if x.GetScheme() == "https" {
+		if x.Mode == "secure" {
+			x.Mode = ""
+		}
+	}
+	if x.Scheme == "https" {
+		x.Scheme = ""
+	}
+}
+
+func (x *Order) IsDefault() bool {
+	if x.GetScheme() == "https" {
+		if x.Mode != "" && x.Mode != "secure" {
+			return false
+		}
+	}
+	if x.Scheme != "" && x.Scheme != "https" {
+		return false
+	}
+	if d, ok := interface{}(x.Spec).(interface{ IsDefault() bool }); ok && x.Spec != nil && !d.IsDefault() {
+		return false
+	}
+	return true
+}
+
+func NewOrder() *Order {
+	x := &Order{}
+	x.Default()
+	return x
+}
+
+func NewOrderWith(fn func(x *Order)) *Order {
+	x := &Order{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	OrderSpec_Default_Host string = "localhost"
+)
+
+func (x *OrderSpec) Default() {
+	if x.Host == "" {
+		x.Host = OrderSpec_Default_Host
+	}
+}
+
+func (x *OrderSpec) DefaultE() error {
+	var errs defaults.Errors
+	if x.Host == "" {
+		x.Host = OrderSpec_Default_Host
+	}
+	return errs.Err()
+}
+
+func (x *OrderSpec) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Host == "" {
+		x.Host = OrderSpec_Default_Host
+		paths = append(paths, "host")
+	}
+	return paths
+}
+
+func (x *OrderSpec) StripDefaults() {
+	if x.Host == "localhost" {
+		x.Host = ""
+	}
+}
+
+func (x *OrderSpec) IsDefault() bool {
+	if x.Host != "" && x.Host != "localhost" {
+		return false
+	}
+	return true
+}
+
+func NewOrderSpec() *OrderSpec {
+	x := &OrderSpec{}
+	x.Default()
+	return x
+}
+
+func NewOrderSpecWith(fn func(x *OrderSpec)) *OrderSpec {
+	x := &OrderSpec{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
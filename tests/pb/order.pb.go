@@ -0,0 +1,284 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/pb/order.proto
+
+package pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	_ "go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string     `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Port   uint32     `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Mode   string     `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	Host   string     `protobuf:"bytes,4,opt,name=host,proto3" json:"host,omitempty"`
+	Scheme string     `protobuf:"bytes,5,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Spec   *OrderSpec `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_order_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_order_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_tests_pb_order_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Order) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Order) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Order) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *Order) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Order) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *Order) GetSpec() *OrderSpec {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+type OrderSpec struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *OrderSpec) Reset() {
+	*x = OrderSpec{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_order_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OrderSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderSpec) ProtoMessage() {}
+
+func (x *OrderSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_order_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderSpec.ProtoReflect.Descriptor instead.
+func (*OrderSpec) Descriptor() ([]byte, []int) {
+	return file_tests_pb_order_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *OrderSpec) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+var File_tests_pb_order_proto protoreflect.FileDescriptor
+
+var file_tests_pb_order_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x02, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x12, 0x42, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x30, 0x9a,
+	0x49, 0x2d, 0xda, 0x01, 0x2a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x20, 0x2b, 0x20, 0x27, 0x3a,
+	0x2f, 0x2f, 0x27, 0x20, 0x2b, 0x20, 0x68, 0x6f, 0x73, 0x74, 0x20, 0x2b, 0x20, 0x27, 0x3a, 0x27,
+	0x20, 0x2b, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x70, 0x6f, 0x72, 0x74, 0x29, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x38, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x24, 0x9a, 0x49, 0x21, 0xda, 0x01, 0x1e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
+	0x20, 0x3d, 0x3d, 0x20, 0x27, 0x68, 0x74, 0x74, 0x70, 0x73, 0x27, 0x20, 0x3f, 0x20, 0x34, 0x34,
+	0x33, 0x75, 0x20, 0x3a, 0x20, 0x38, 0x30, 0x75, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x30,
+	0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0xa2, 0x49,
+	0x19, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x22, 0x08, 0x72, 0x06, 0x73, 0x65, 0x63,
+	0x75, 0x72, 0x65, 0x12, 0x05, 0x68, 0x74, 0x74, 0x70, 0x73, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65,
+	0x12, 0x23, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f,
+	0x9a, 0x49, 0x0c, 0xda, 0x01, 0x09, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x68, 0x6f, 0x73, 0x74, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x72, 0x05, 0x68, 0x74, 0x74, 0x70,
+	0x73, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x70, 0x65,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01,
+	0x04, 0x08, 0x01, 0x10, 0x01, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x22, 0x2f, 0x0a, 0x09, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x70, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e, 0x9a, 0x49, 0x0b, 0x72, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x2d, 0x5a, 0x2b,
+	0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_pb_order_proto_rawDescOnce sync.Once
+	file_tests_pb_order_proto_rawDescData = file_tests_pb_order_proto_rawDesc
+)
+
+func file_tests_pb_order_proto_rawDescGZIP() []byte {
+	file_tests_pb_order_proto_rawDescOnce.Do(func() {
+		file_tests_pb_order_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_order_proto_rawDescData)
+	})
+	return file_tests_pb_order_proto_rawDescData
+}
+
+var file_tests_pb_order_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_pb_order_proto_goTypes = []interface{}{
+	(*Order)(nil),     // 0: tests.Order
+	(*OrderSpec)(nil), // 1: tests.OrderSpec
+}
+var file_tests_pb_order_proto_depIdxs = []int32{
+	1, // 0: tests.Order.spec:type_name -> tests.OrderSpec
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_order_proto_init() }
+func file_tests_pb_order_proto_init() {
+	if File_tests_pb_order_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_order_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_order_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrderSpec); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_pb_order_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_pb_order_proto_goTypes,
+		DependencyIndexes: file_tests_pb_order_proto_depIdxs,
+		MessageInfos:      file_tests_pb_order_proto_msgTypes,
+	}.Build()
+	File_tests_pb_order_proto = out.File
+	file_tests_pb_order_proto_rawDesc = nil
+	file_tests_pb_order_proto_goTypes = nil
+	file_tests_pb_order_proto_depIdxs = nil
+}
@@ -0,0 +1,400 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package pb
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Proto2_Default_Number    int64         = 42
+	Proto2_Default_Name      string        = "native \"name\""
+	Proto2_Default_Flag      bool          = true
+	Proto2_Default_Level     Proto2_Level  = Proto2_LOW
+	Proto2_Default_Ratio     float64       = 0.5
+	Proto2_Default_Fixed     uint32        = 7
+	Proto2_Default_Id        uint64        = 8
+	Proto2_Default_Both      int32         = 9
+	Proto2_Default_LevelBoth Proto2_Level  = 1
+	Proto2_Default_Declared  string        = "declared"
+	Proto2_Default_Timeout   time.Duration = 10000000000
+)
+
+var (
+	Proto2_Default_Data = []byte("\x01raw")
+)
+
+func (x *Proto2) Default() {
+	if x.Number == nil {
+		v := int64(Proto2_Default_Number)
+		x.Number = &v
+	}
+	if x.Name == nil {
+		v := string(Proto2_Default_Name)
+		x.Name = &v
+	}
+	if x.Flag == nil {
+		v := bool(Proto2_Default_Flag)
+		x.Flag = &v
+	}
+	if x.Level == nil {
+		v := Proto2_Level(Proto2_Default_Level)
+		x.Level = &v
+	}
+	if x.Data == nil {
+		x.Data = append([]byte(nil), Proto2_Default_Data...)
+	}
+	if x.Ratio == nil {
+		v := float64(Proto2_Default_Ratio)
+		x.Ratio = &v
+	}
+	if x.Fixed == nil {
+		v := uint32(Proto2_Default_Fixed)
+		x.Fixed = &v
+	}
+	if x.Id == nil {
+		v := uint64(Proto2_Default_Id)
+		x.Id = &v
+	}
+	if x.Both == nil {
+		v := int32(Proto2_Default_Both)
+		x.Both = &v
+	}
+	if x.LevelBoth == nil {
+		v := Proto2_Level(Proto2_Default_LevelBoth)
+		x.LevelBoth = &v
+	}
+	if x.Declared == nil {
+		v := string(Proto2_Default_Declared)
+		x.Declared = &v
+	}
+	if x.Timeout == nil {
+		x.Timeout = durationpb.New(Proto2_Default_Timeout)
+	}
+	if x.Nested == nil {
+		x.Nested = &Proto2Nested{}
+	}
+	if v, ok := interface{}(x.Nested).(interface{ Default() }); ok && x.Nested != nil {
+		v.Default()
+	}
+}
+
+func (x *Proto2) DefaultE() error {
+	var errs defaults.Errors
+	if x.Number == nil {
+		v := int64(Proto2_Default_Number)
+		x.Number = &v
+	}
+	if x.Name == nil {
+		v := string(Proto2_Default_Name)
+		x.Name = &v
+	}
+	if x.Flag == nil {
+		v := bool(Proto2_Default_Flag)
+		x.Flag = &v
+	}
+	if x.Level == nil {
+		v := Proto2_Level(Proto2_Default_Level)
+		x.Level = &v
+	}
+	if x.Data == nil {
+		x.Data = append([]byte(nil), Proto2_Default_Data...)
+	}
+	if x.Ratio == nil {
+		v := float64(Proto2_Default_Ratio)
+		x.Ratio = &v
+	}
+	if x.Fixed == nil {
+		v := uint32(Proto2_Default_Fixed)
+		x.Fixed = &v
+	}
+	if x.Id == nil {
+		v := uint64(Proto2_Default_Id)
+		x.Id = &v
+	}
+	if x.Both == nil {
+		v := int32(Proto2_Default_Both)
+		x.Both = &v
+	}
+	if x.LevelBoth == nil {
+		v := Proto2_Level(Proto2_Default_LevelBoth)
+		x.LevelBoth = &v
+	}
+	if x.Declared == nil {
+		v := string(Proto2_Default_Declared)
+		x.Declared = &v
+	}
+	if x.Timeout == nil {
+		x.Timeout = durationpb.New(Proto2_Default_Timeout)
+	}
+	if x.Nested == nil {
+		x.Nested = &Proto2Nested{}
+	}
+	if v, ok := interface{}(x.Nested).(interface{ DefaultE() error }); ok && x.Nested != nil {
+		errs.Add("nested", v.DefaultE())
+	} else if v, ok := interface{}(x.Nested).(interface{ Default() }); ok && x.Nested != nil {
+		v.Default()
+	}
+	return errs.Err()
+}
+
+func (x *Proto2) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Number == nil {
+		v := int64(Proto2_Default_Number)
+		x.Number = &v
+		paths = append(paths, "number")
+	}
+	if x.Name == nil {
+		v := string(Proto2_Default_Name)
+		x.Name = &v
+		paths = append(paths, "name")
+	}
+	if x.Flag == nil {
+		v := bool(Proto2_Default_Flag)
+		x.Flag = &v
+		paths = append(paths, "flag")
+	}
+	if x.Level == nil {
+		v := Proto2_Level(Proto2_Default_Level)
+		x.Level = &v
+		paths = append(paths, "level")
+	}
+	if x.Data == nil {
+		x.Data = append([]byte(nil), Proto2_Default_Data...)
+		paths = append(paths, "data")
+	}
+	if x.Ratio == nil {
+		v := float64(Proto2_Default_Ratio)
+		x.Ratio = &v
+		paths = append(paths, "ratio")
+	}
+	if x.Fixed == nil {
+		v := uint32(Proto2_Default_Fixed)
+		x.Fixed = &v
+		paths = append(paths, "fixed")
+	}
+	if x.Id == nil {
+		v := uint64(Proto2_Default_Id)
+		x.Id = &v
+		paths = append(paths, "id")
+	}
+	if x.Both == nil {
+		v := int32(Proto2_Default_Both)
+		x.Both = &v
+		paths = append(paths, "both")
+	}
+	if x.LevelBoth == nil {
+		v := Proto2_Level(Proto2_Default_LevelBoth)
+		x.LevelBoth = &v
+		paths = append(paths, "level_both")
+	}
+	if x.Declared == nil {
+		v := string(Proto2_Default_Declared)
+		x.Declared = &v
+		paths = append(paths, "declared")
+	}
+	if x.Timeout == nil {
+		x.Timeout = durationpb.New(Proto2_Default_Timeout)
+		paths = append(paths, "timeout")
+	}
+	if x.Nested == nil {
+		x.Nested = &Proto2Nested{}
+		paths = append(paths, "nested")
+	}
+	if v, ok := interface{}(x.Nested).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Nested != nil {
+		paths = append(paths, defaults.PrefixPaths("nested", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Nested).(interface{ Default() }); ok && x.Nested != nil {
+		v.Default()
+	}
+	return paths
+}
+
+func (x *Proto2) StripDefaults() {
+	if s, ok := interface{}(x.Nested).(interface{ StripDefaults() }); ok && x.Nested != nil {
+		s.StripDefaults()
+	}
+	if x.Nested != nil && proto.Size(x.Nested) == 0 {
+		x.Nested = nil
+	}
+	if x.Timeout != nil && proto.Equal(x.Timeout, durationpb.New(10000000000)) {
+		x.Timeout = nil
+	}
+	if x.Declared != nil && *x.Declared == "declared" {
+		x.Declared = nil
+	}
+	if x.LevelBoth != nil && *x.LevelBoth == Proto2_Level(1) {
+		x.LevelBoth = nil
+	}
+	if x.Both != nil && *x.Both == 9 {
+		x.Both = nil
+	}
+	if x.Id != nil && *x.Id == 8 {
+		x.Id = nil
+	}
+	if x.Fixed != nil && *x.Fixed == 7 {
+		x.Fixed = nil
+	}
+	if x.Ratio != nil && *x.Ratio == 0.5 {
+		x.Ratio = nil
+	}
+	if string(x.Data) == "\x01raw" {
+		x.Data = nil
+	}
+	if x.Level != nil && *x.Level == Proto2_LOW {
+		x.Level = nil
+	}
+	if x.Flag != nil && *x.Flag == true {
+		x.Flag = nil
+	}
+	if x.Name != nil && *x.Name == "native \"name\"" {
+		x.Name = nil
+	}
+	if x.Number != nil && *x.Number == 42 {
+		x.Number = nil
+	}
+}
+
+func (x *Proto2) IsDefault() bool {
+	if x.Number != nil && *x.Number != 42 {
+		return false
+	}
+	if x.Name != nil && *x.Name != "native \"name\"" {
+		return false
+	}
+	if x.Flag != nil && *x.Flag != true {
+		return false
+	}
+	if x.Level != nil && *x.Level != Proto2_LOW {
+		return false
+	}
+	if x.Data != nil && string(x.Data) != "\x01raw" {
+		return false
+	}
+	if x.Ratio != nil && *x.Ratio != 0.5 {
+		return false
+	}
+	if x.Fixed != nil && *x.Fixed != 7 {
+		return false
+	}
+	if x.Id != nil && *x.Id != 8 {
+		return false
+	}
+	if x.Both != nil && *x.Both != 9 {
+		return false
+	}
+	if x.LevelBoth != nil && *x.LevelBoth != Proto2_Level(1) {
+		return false
+	}
+	if x.Declared != nil && *x.Declared != "declared" {
+		return false
+	}
+	if x.Timeout != nil && !proto.Equal(x.Timeout, durationpb.New(10000000000)) {
+		return false
+	}
+	if d, ok := interface{}(x.Nested).(interface{ IsDefault() bool }); ok && x.Nested != nil && !d.IsDefault() {
+		return false
+	}
+	return true
+}
+
+func NewProto2() *Proto2 {
+	x := &Proto2{}
+	x.Default()
+	return x
+}
+
+func NewProto2With(fn func(x *Proto2)) *Proto2 {
+	x := &Proto2{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	Proto2Nested_Default_Value string = "nested"
+)
+
+func (x *Proto2Nested) Default() {
+	if x.Value == nil {
+		v := string(Proto2Nested_Default_Value)
+		x.Value = &v
+	}
+}
+
+func (x *Proto2Nested) DefaultE() error {
+	var errs defaults.Errors
+	if x.Value == nil {
+		v := string(Proto2Nested_Default_Value)
+		x.Value = &v
+	}
+	return errs.Err()
+}
+
+func (x *Proto2Nested) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Value == nil {
+		v := string(Proto2Nested_Default_Value)
+		x.Value = &v
+		paths = append(paths, "value")
+	}
+	return paths
+}
+
+func (x *Proto2Nested) StripDefaults() {
+	if x.Value != nil && *x.Value == "nested" {
+		x.Value = nil
+	}
+}
+
+func (x *Proto2Nested) IsDefault() bool {
+	if x.Value != nil && *x.Value != "nested" {
+		return false
+	}
+	return true
+}
+
+func NewProto2Nested() *Proto2Nested {
+	x := &Proto2Nested{}
+	x.Default()
+	return x
+}
+
+func NewProto2NestedWith(fn func(x *Proto2Nested)) *Proto2Nested {
+	x := &Proto2Nested{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
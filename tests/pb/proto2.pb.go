@@ -0,0 +1,456 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/pb/proto2.proto
+
+package pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+
+	_ "go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Proto2_Level int32
+
+const (
+	Proto2_LOW    Proto2_Level = -1
+	Proto2_NORMAL Proto2_Level = 0
+	Proto2_HIGH   Proto2_Level = 1
+)
+
+// Enum value maps for Proto2_Level.
+var (
+	Proto2_Level_name = map[int32]string{
+		-1: "LOW",
+		0:  "NORMAL",
+		1:  "HIGH",
+	}
+	Proto2_Level_value = map[string]int32{
+		"LOW":    -1,
+		"NORMAL": 0,
+		"HIGH":   1,
+	}
+)
+
+func (x Proto2_Level) Enum() *Proto2_Level {
+	p := new(Proto2_Level)
+	*p = x
+	return p
+}
+
+func (x Proto2_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Proto2_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_pb_proto2_proto_enumTypes[0].Descriptor()
+}
+
+func (Proto2_Level) Type() protoreflect.EnumType {
+	return &file_tests_pb_proto2_proto_enumTypes[0]
+}
+
+func (x Proto2_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Proto2_Level) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Proto2_Level(num)
+	return nil
+}
+
+// Deprecated: Use Proto2_Level.Descriptor instead.
+func (Proto2_Level) EnumDescriptor() ([]byte, []int) {
+	return file_tests_pb_proto2_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Proto2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number    *int64               `protobuf:"varint,1,opt,name=number,def=42" json:"number,omitempty"`
+	Name      *string              `protobuf:"bytes,2,opt,name=name,def=native \"name\"" json:"name,omitempty"`
+	Flag      *bool                `protobuf:"varint,3,opt,name=flag,def=1" json:"flag,omitempty"`
+	Level     *Proto2_Level        `protobuf:"varint,4,opt,name=level,enum=tests.Proto2_Level,def=-1" json:"level,omitempty"`
+	Data      []byte               `protobuf:"bytes,5,opt,name=data,def=\\001raw" json:"data,omitempty"`
+	Ratio     *float64             `protobuf:"fixed64,6,opt,name=ratio,def=0.5" json:"ratio,omitempty"`
+	Fixed     *uint32              `protobuf:"fixed32,7,opt,name=fixed,def=7" json:"fixed,omitempty"`
+	Id        *uint64              `protobuf:"varint,8,req,name=id,def=8" json:"id,omitempty"`
+	Both      *int32               `protobuf:"varint,9,opt,name=both,def=9" json:"both,omitempty"`
+	LevelBoth *Proto2_Level        `protobuf:"varint,10,opt,name=level_both,json=levelBoth,enum=tests.Proto2_Level,def=1" json:"level_both,omitempty"`
+	Declared  *string              `protobuf:"bytes,11,opt,name=declared" json:"declared,omitempty"`
+	Timeout   *durationpb.Duration `protobuf:"bytes,12,opt,name=timeout" json:"timeout,omitempty"`
+	None      *int32               `protobuf:"varint,13,opt,name=none" json:"none,omitempty"`
+	Nested    *Proto2Nested        `protobuf:"bytes,14,opt,name=nested" json:"nested,omitempty"`
+}
+
+// Default values for Proto2 fields.
+const (
+	Default_Proto2_Number    = int64(42)
+	Default_Proto2_Name      = string("native \"name\"")
+	Default_Proto2_Flag      = bool(true)
+	Default_Proto2_Level     = Proto2_LOW
+	Default_Proto2_Ratio     = float64(0.5)
+	Default_Proto2_Fixed     = uint32(7)
+	Default_Proto2_Id        = uint64(8)
+	Default_Proto2_Both      = int32(9)
+	Default_Proto2_LevelBoth = Proto2_HIGH
+)
+
+// Default values for Proto2 fields.
+var (
+	Default_Proto2_Data = []byte("\x01raw")
+)
+
+func (x *Proto2) Reset() {
+	*x = Proto2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_proto2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2) ProtoMessage() {}
+
+func (x *Proto2) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_proto2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2.ProtoReflect.Descriptor instead.
+func (*Proto2) Descriptor() ([]byte, []int) {
+	return file_tests_pb_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Proto2) GetNumber() int64 {
+	if x != nil && x.Number != nil {
+		return *x.Number
+	}
+	return Default_Proto2_Number
+}
+
+func (x *Proto2) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return Default_Proto2_Name
+}
+
+func (x *Proto2) GetFlag() bool {
+	if x != nil && x.Flag != nil {
+		return *x.Flag
+	}
+	return Default_Proto2_Flag
+}
+
+func (x *Proto2) GetLevel() Proto2_Level {
+	if x != nil && x.Level != nil {
+		return *x.Level
+	}
+	return Default_Proto2_Level
+}
+
+func (x *Proto2) GetData() []byte {
+	if x != nil && x.Data != nil {
+		return x.Data
+	}
+	return append([]byte(nil), Default_Proto2_Data...)
+}
+
+func (x *Proto2) GetRatio() float64 {
+	if x != nil && x.Ratio != nil {
+		return *x.Ratio
+	}
+	return Default_Proto2_Ratio
+}
+
+func (x *Proto2) GetFixed() uint32 {
+	if x != nil && x.Fixed != nil {
+		return *x.Fixed
+	}
+	return Default_Proto2_Fixed
+}
+
+func (x *Proto2) GetId() uint64 {
+	if x != nil && x.Id != nil {
+		return *x.Id
+	}
+	return Default_Proto2_Id
+}
+
+func (x *Proto2) GetBoth() int32 {
+	if x != nil && x.Both != nil {
+		return *x.Both
+	}
+	return Default_Proto2_Both
+}
+
+func (x *Proto2) GetLevelBoth() Proto2_Level {
+	if x != nil && x.LevelBoth != nil {
+		return *x.LevelBoth
+	}
+	return Default_Proto2_LevelBoth
+}
+
+func (x *Proto2) GetDeclared() string {
+	if x != nil && x.Declared != nil {
+		return *x.Declared
+	}
+	return ""
+}
+
+func (x *Proto2) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Proto2) GetNone() int32 {
+	if x != nil && x.None != nil {
+		return *x.None
+	}
+	return 0
+}
+
+func (x *Proto2) GetNested() *Proto2Nested {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+type Proto2Nested struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *string `protobuf:"bytes,1,opt,name=value,def=nested" json:"value,omitempty"`
+}
+
+// Default values for Proto2Nested fields.
+const (
+	Default_Proto2Nested_Value = string("nested")
+)
+
+func (x *Proto2Nested) Reset() {
+	*x = Proto2Nested{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_proto2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2Nested) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2Nested) ProtoMessage() {}
+
+func (x *Proto2Nested) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_proto2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2Nested.ProtoReflect.Descriptor instead.
+func (*Proto2Nested) Descriptor() ([]byte, []int) {
+	return file_tests_pb_proto2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Proto2Nested) GetValue() string {
+	if x != nil && x.Value != nil {
+		return *x.Value
+	}
+	return Default_Proto2Nested_Value
+}
+
+var File_tests_pb_proto2_proto protoreflect.FileDescriptor
+
+var file_tests_pb_proto2_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbe, 0x04, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x32, 0x12, 0x1a, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x3a, 0x02, 0x34, 0x32, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x21,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x0d, 0x6e, 0x61,
+	0x74, 0x69, 0x76, 0x65, 0x20, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x3a,
+	0x04, 0x74, 0x72, 0x75, 0x65, 0x52, 0x04, 0x66, 0x6c, 0x61, 0x67, 0x12, 0x2e, 0x0a, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a,
+	0x03, 0x4c, 0x4f, 0x57, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1b, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x3a, 0x07, 0x5c, 0x30, 0x30, 0x31, 0x72,
+	0x61, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x3a, 0x03, 0x30, 0x2e, 0x35, 0x52, 0x05, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x12, 0x17, 0x0a, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x07, 0x3a, 0x01, 0x37, 0x52, 0x05, 0x66, 0x69, 0x78, 0x65, 0x64, 0x12, 0x11, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x02, 0x28, 0x04, 0x3a, 0x01, 0x38, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1c, 0x0a, 0x04, 0x62, 0x6f, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x3a, 0x01, 0x39,
+	0x42, 0x05, 0x9a, 0x49, 0x02, 0x18, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x74, 0x68, 0x12, 0x40, 0x0a,
+	0x0a, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x5f, 0x62, 0x6f, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32,
+	0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x3a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x42, 0x06, 0x9a, 0x49,
+	0x03, 0x80, 0x01, 0x01, 0x52, 0x09, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x6f, 0x74, 0x68, 0x12,
+	0x29, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0x9a, 0x49, 0x0a, 0x72, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64,
+	0x52, 0x08, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x09, 0x9a, 0x49, 0x06, 0xaa, 0x01, 0x03, 0x31, 0x30,
+	0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f,
+	0x6e, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6e, 0x6f, 0x6e, 0x65, 0x12, 0x37,
+	0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x4e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x01, 0x52,
+	0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x2f, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x12, 0x10, 0x0a, 0x03, 0x4c, 0x4f, 0x57, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x08,
+	0x0a, 0x04, 0x48, 0x49, 0x47, 0x48, 0x10, 0x01, 0x22, 0x2c, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x32, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x3a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x30, 0x98, 0x49, 0x01, 0x5a, 0x2b, 0x67, 0x6f, 0x2e,
+	0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62,
+}
+
+var (
+	file_tests_pb_proto2_proto_rawDescOnce sync.Once
+	file_tests_pb_proto2_proto_rawDescData = file_tests_pb_proto2_proto_rawDesc
+)
+
+func file_tests_pb_proto2_proto_rawDescGZIP() []byte {
+	file_tests_pb_proto2_proto_rawDescOnce.Do(func() {
+		file_tests_pb_proto2_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_proto2_proto_rawDescData)
+	})
+	return file_tests_pb_proto2_proto_rawDescData
+}
+
+var file_tests_pb_proto2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_pb_proto2_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_tests_pb_proto2_proto_goTypes = []interface{}{
+	(Proto2_Level)(0),           // 0: tests.Proto2.Level
+	(*Proto2)(nil),              // 1: tests.Proto2
+	(*Proto2Nested)(nil),        // 2: tests.Proto2Nested
+	(*durationpb.Duration)(nil), // 3: google.protobuf.Duration
+}
+var file_tests_pb_proto2_proto_depIdxs = []int32{
+	0, // 0: tests.Proto2.level:type_name -> tests.Proto2.Level
+	0, // 1: tests.Proto2.level_both:type_name -> tests.Proto2.Level
+	3, // 2: tests.Proto2.timeout:type_name -> google.protobuf.Duration
+	2, // 3: tests.Proto2.nested:type_name -> tests.Proto2Nested
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_proto2_proto_init() }
+func file_tests_pb_proto2_proto_init() {
+	if File_tests_pb_proto2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_proto2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_proto2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2Nested); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_pb_proto2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_pb_proto2_proto_goTypes,
+		DependencyIndexes: file_tests_pb_proto2_proto_depIdxs,
+		EnumInfos:         file_tests_pb_proto2_proto_enumTypes,
+		MessageInfos:      file_tests_pb_proto2_proto_msgTypes,
+	}.Build()
+	File_tests_pb_proto2_proto = out.File
+	file_tests_pb_proto2_proto_rawDesc = nil
+	file_tests_pb_proto2_proto_goTypes = nil
+	file_tests_pb_proto2_proto_depIdxs = nil
+}
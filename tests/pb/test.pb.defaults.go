@@ -17,48 +17,71 @@
 package pb
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
 )
 
 var (
 	_ *timestamppb.Timestamp
 	_ *durationpb.Duration
 	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Test_Default_StringField        string        = "string_field"
+	Test_Default_NumberField        int64         = 42
+	Test_Default_BoolField          bool          = true
+	Test_Default_EnumField          Test_Type     = 2
+	Test_Default_NumberValueField   int64         = 43
+	Test_Default_StringValueField   string        = "string_value"
+	Test_Default_BoolValueField     bool          = false
+	Test_Default_DurationValueField time.Duration = 25401600000000000
+	Test_Default_Four               Test_Type     = 1
+)
+
+var (
+	Test_Default_TimeValueFieldWithDefault = time.Unix(-562032000, 0).UTC()
+	Test_Default_Bytes                     = []byte("??")
 )
 
 func (x *Test) Default() {
 	if x.StringField == "" {
-		x.StringField = "string_field"
+		x.StringField = Test_Default_StringField
 	}
 	if x.NumberField == 0 {
-		x.NumberField = 42
+		x.NumberField = Test_Default_NumberField
 	}
 	if x.BoolField == false {
-		x.BoolField = true
+		x.BoolField = Test_Default_BoolField
 	}
 	if x.EnumField == 0 {
-		x.EnumField = 2
+		x.EnumField = Test_Default_EnumField
 	}
 	if v, ok := interface{}(x.MessageField).(interface{ Default() }); ok && x.MessageField != nil {
 		v.Default()
 	}
 	if x.NumberValueField == nil {
-		x.NumberValueField = &wrapperspb.Int64Value{Value: 43}
+		x.NumberValueField = &wrapperspb.Int64Value{Value: Test_Default_NumberValueField}
 	}
 	if x.StringValueField == nil {
-		x.StringValueField = &wrapperspb.StringValue{Value: "string_value"}
+		x.StringValueField = &wrapperspb.StringValue{Value: Test_Default_StringValueField}
 	}
 	if x.BoolValueField == nil {
-		x.BoolValueField = &wrapperspb.BoolValue{Value: false}
+		x.BoolValueField = &wrapperspb.BoolValue{Value: Test_Default_BoolValueField}
 	}
 	if x.TimeValueField == nil {
 		x.TimeValueField = timestamppb.Now()
 	}
 	if x.DurationValueField == nil {
-		x.DurationValueField = durationpb.New(25401600000000000)
+		x.DurationValueField = durationpb.New(Test_Default_DurationValueField)
 	}
 	if x.Oneof == nil {
 		x.Oneof = &Test_Two{}
@@ -87,7 +110,7 @@ func (x *Test) Default() {
 		}
 	case *Test_Four:
 		if x.Four == 0 {
-			x.Four = 1
+			x.Four = Test_Default_Four
 		}
 	}
 	if x.Descriptor_ == nil {
@@ -97,15 +120,433 @@ func (x *Test) Default() {
 		v.Default()
 	}
 	if x.TimeValueFieldWithDefault == nil {
-		x.TimeValueFieldWithDefault = &timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}
+		x.TimeValueFieldWithDefault = timestamppb.New(Test_Default_TimeValueFieldWithDefault)
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = append([]byte(nil), Test_Default_Bytes...)
+	}
+}
+
+func (x *Test) DefaultE() error {
+	var errs defaults.Errors
+	if x.StringField == "" {
+		x.StringField = Test_Default_StringField
+	}
+	if x.NumberField == 0 {
+		x.NumberField = Test_Default_NumberField
+	}
+	if x.BoolField == false {
+		x.BoolField = Test_Default_BoolField
+	}
+	if x.EnumField == 0 {
+		x.EnumField = Test_Default_EnumField
+	}
+	if v, ok := interface{}(x.MessageField).(interface{ DefaultE() error }); ok && x.MessageField != nil {
+		errs.Add("message_field", v.DefaultE())
+	} else if v, ok := interface{}(x.MessageField).(interface{ Default() }); ok && x.MessageField != nil {
+		v.Default()
+	}
+	if x.NumberValueField == nil {
+		x.NumberValueField = &wrapperspb.Int64Value{Value: Test_Default_NumberValueField}
+	}
+	if x.StringValueField == nil {
+		x.StringValueField = &wrapperspb.StringValue{Value: Test_Default_StringValueField}
+	}
+	if x.BoolValueField == nil {
+		x.BoolValueField = &wrapperspb.BoolValue{Value: Test_Default_BoolValueField}
+	}
+	if x.TimeValueField == nil {
+		x.TimeValueField = timestamppb.Now()
+	}
+	if x.DurationValueField == nil {
+		x.DurationValueField = durationpb.New(Test_Default_DurationValueField)
+	}
+	if x.Oneof == nil {
+		x.Oneof = &Test_Two{}
+	}
+	switch x := x.Oneof.(type) {
+	case *Test_One:
+		if x.One == nil {
+			x.One = &OneOfOne{}
+		}
+		if v, ok := interface{}(x.One).(interface{ DefaultE() error }); ok && x.One != nil {
+			errs.Add("one", v.DefaultE())
+		} else if v, ok := interface{}(x.One).(interface{ Default() }); ok && x.One != nil {
+			v.Default()
+		}
+	case *Test_Two:
+		if x.Two == nil {
+			x.Two = &OneOfTwo{}
+		}
+		if v, ok := interface{}(x.Two).(interface{ DefaultE() error }); ok && x.Two != nil {
+			errs.Add("two", v.DefaultE())
+		} else if v, ok := interface{}(x.Two).(interface{ Default() }); ok && x.Two != nil {
+			v.Default()
+		}
+	case *Test_Three:
+		if x.Three == nil {
+			x.Three = &OneOfThree{}
+		}
+		if v, ok := interface{}(x.Three).(interface{ DefaultE() error }); ok && x.Three != nil {
+			errs.Add("three", v.DefaultE())
+		} else if v, ok := interface{}(x.Three).(interface{ Default() }); ok && x.Three != nil {
+			v.Default()
+		}
+	case *Test_Four:
+		if x.Four == 0 {
+			x.Four = Test_Default_Four
+		}
+	}
+	if x.Descriptor_ == nil {
+		x.Descriptor_ = &descriptorpb.DescriptorProto{}
+	}
+	if v, ok := interface{}(x.Descriptor_).(interface{ DefaultE() error }); ok && x.Descriptor_ != nil {
+		errs.Add("descriptor", v.DefaultE())
+	} else if v, ok := interface{}(x.Descriptor_).(interface{ Default() }); ok && x.Descriptor_ != nil {
+		v.Default()
+	}
+	if x.TimeValueFieldWithDefault == nil {
+		x.TimeValueFieldWithDefault = timestamppb.New(Test_Default_TimeValueFieldWithDefault)
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = append([]byte(nil), Test_Default_Bytes...)
+	}
+	return errs.Err()
+}
 
+func (x *Test) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.StringField == "" {
+		x.StringField = Test_Default_StringField
+		paths = append(paths, "string_field")
+	}
+	if x.NumberField == 0 {
+		x.NumberField = Test_Default_NumberField
+		paths = append(paths, "number_field")
+	}
+	if x.BoolField == false {
+		x.BoolField = Test_Default_BoolField
+		paths = append(paths, "bool_field")
+	}
+	if x.EnumField == 0 {
+		x.EnumField = Test_Default_EnumField
+		paths = append(paths, "enum_field")
+	}
+	if v, ok := interface{}(x.MessageField).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.MessageField != nil {
+		paths = append(paths, defaults.PrefixPaths("message_field", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.MessageField).(interface{ Default() }); ok && x.MessageField != nil {
+		v.Default()
+	}
+	if x.NumberValueField == nil {
+		x.NumberValueField = &wrapperspb.Int64Value{Value: Test_Default_NumberValueField}
+		paths = append(paths, "number_value_field")
+	}
+	if x.StringValueField == nil {
+		x.StringValueField = &wrapperspb.StringValue{Value: Test_Default_StringValueField}
+		paths = append(paths, "string_value_field")
+	}
+	if x.BoolValueField == nil {
+		x.BoolValueField = &wrapperspb.BoolValue{Value: Test_Default_BoolValueField}
+		paths = append(paths, "bool_value_field")
+	}
+	if x.TimeValueField == nil {
+		x.TimeValueField = timestamppb.Now()
+		paths = append(paths, "time_value_field")
+	}
+	if x.DurationValueField == nil {
+		x.DurationValueField = durationpb.New(Test_Default_DurationValueField)
+		paths = append(paths, "duration_value_field")
+	}
+	if x.Oneof == nil {
+		x.Oneof = &Test_Two{}
+	}
+	switch x := x.Oneof.(type) {
+	case *Test_One:
+		if x.One == nil {
+			x.One = &OneOfOne{}
+			paths = append(paths, "one")
+		}
+		if v, ok := interface{}(x.One).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.One != nil {
+			paths = append(paths, defaults.PrefixPaths("one", v.DefaultReport())...)
+		} else if v, ok := interface{}(x.One).(interface{ Default() }); ok && x.One != nil {
+			v.Default()
+		}
+	case *Test_Two:
+		if x.Two == nil {
+			x.Two = &OneOfTwo{}
+			paths = append(paths, "two")
+		}
+		if v, ok := interface{}(x.Two).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Two != nil {
+			paths = append(paths, defaults.PrefixPaths("two", v.DefaultReport())...)
+		} else if v, ok := interface{}(x.Two).(interface{ Default() }); ok && x.Two != nil {
+			v.Default()
+		}
+	case *Test_Three:
+		if x.Three == nil {
+			x.Three = &OneOfThree{}
+			paths = append(paths, "three")
+		}
+		if v, ok := interface{}(x.Three).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Three != nil {
+			paths = append(paths, defaults.PrefixPaths("three", v.DefaultReport())...)
+		} else if v, ok := interface{}(x.Three).(interface{ Default() }); ok && x.Three != nil {
+			v.Default()
+		}
+	case *Test_Four:
+		if x.Four == 0 {
+			x.Four = Test_Default_Four
+			paths = append(paths, "four")
+		}
+	}
+	if x.Descriptor_ == nil {
+		x.Descriptor_ = &descriptorpb.DescriptorProto{}
+		paths = append(paths, "descriptor")
+	}
+	if v, ok := interface{}(x.Descriptor_).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Descriptor_ != nil {
+		paths = append(paths, defaults.PrefixPaths("descriptor", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Descriptor_).(interface{ Default() }); ok && x.Descriptor_ != nil {
+		v.Default()
+	}
+	if x.TimeValueFieldWithDefault == nil {
+		x.TimeValueFieldWithDefault = timestamppb.New(Test_Default_TimeValueFieldWithDefault)
+		paths = append(paths, "time_value_field_with_default")
 	}
 	if len(x.Bytes) == 0 {
-		x.Bytes = []byte("??")
+		x.Bytes = append([]byte(nil), Test_Default_Bytes...)
+		paths = append(paths, "bytes")
+	}
+	return paths
+}
+
+func (x *Test) StripDefaults() {
+	if string(x.Bytes) == "??" {
+		x.Bytes = nil
+	}
+	if x.TimeValueFieldWithDefault != nil && proto.Equal(x.TimeValueFieldWithDefault, &timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}) {
+		x.TimeValueFieldWithDefault = nil
+	}
+	if s, ok := interface{}(x.Descriptor_).(interface{ StripDefaults() }); ok && x.Descriptor_ != nil {
+		s.StripDefaults()
+	}
+	if x.Descriptor_ != nil && proto.Size(x.Descriptor_) == 0 {
+		x.Descriptor_ = nil
+	}
+	switch o := x.Oneof.(type) {
+	case *Test_One:
+		if s, ok := interface{}(o.One).(interface{ StripDefaults() }); ok && o.One != nil {
+			s.StripDefaults()
+		}
+	case *Test_Two:
+		if s, ok := interface{}(o.Two).(interface{ StripDefaults() }); ok && o.Two != nil {
+			s.StripDefaults()
+		}
+		if o.Two != nil && proto.Size(o.Two) == 0 {
+			x.Oneof = nil
+		}
+	case *Test_Three:
+		if s, ok := interface{}(o.Three).(interface{ StripDefaults() }); ok && o.Three != nil {
+			s.StripDefaults()
+		}
+	}
+	if x.DurationValueField != nil && proto.Equal(x.DurationValueField, durationpb.New(25401600000000000)) {
+		x.DurationValueField = nil
+	}
+	if x.BoolValueField != nil && proto.Equal(x.BoolValueField, &wrapperspb.BoolValue{Value: false}) {
+		x.BoolValueField = nil
+	}
+	if x.StringValueField != nil && proto.Equal(x.StringValueField, &wrapperspb.StringValue{Value: "string_value"}) {
+		x.StringValueField = nil
+	}
+	if x.NumberValueField != nil && proto.Equal(x.NumberValueField, &wrapperspb.Int64Value{Value: 43}) {
+		x.NumberValueField = nil
+	}
+	if s, ok := interface{}(x.MessageField).(interface{ StripDefaults() }); ok && x.MessageField != nil {
+		s.StripDefaults()
+	}
+	if x.EnumField == Test_Type(2) {
+		x.EnumField = 0
+	}
+	if x.BoolField == true {
+		x.BoolField = false
+	}
+	if x.NumberField == 42 {
+		x.NumberField = 0
+	}
+	if x.StringField == "string_field" {
+		x.StringField = ""
+	}
+}
+
+func (x *Test) IsDefault() bool {
+	if x.StringField != "" && x.StringField != "string_field" {
+		return false
+	}
+	if x.NumberField != 0 && x.NumberField != 42 {
+		return false
+	}
+	if x.BoolField != false && x.BoolField != true {
+		return false
+	}
+	if x.EnumField != 0 && x.EnumField != Test_Type(2) {
+		return false
+	}
+	if d, ok := interface{}(x.MessageField).(interface{ IsDefault() bool }); ok && x.MessageField != nil && !d.IsDefault() {
+		return false
+	}
+	if x.NumberValueField != nil && !proto.Equal(x.NumberValueField, &wrapperspb.Int64Value{Value: 43}) {
+		return false
+	}
+	if x.StringValueField != nil && !proto.Equal(x.StringValueField, &wrapperspb.StringValue{Value: "string_value"}) {
+		return false
+	}
+	if x.BoolValueField != nil && !proto.Equal(x.BoolValueField, &wrapperspb.BoolValue{Value: false}) {
+		return false
+	}
+	if x.DurationValueField != nil && !proto.Equal(x.DurationValueField, durationpb.New(25401600000000000)) {
+		return false
+	}
+	switch o := x.Oneof.(type) {
+	case *Test_One:
+		return false
+	case *Test_Two:
+		if d, ok := interface{}(o.Two).(interface{ IsDefault() bool }); ok && o.Two != nil && !d.IsDefault() {
+			return false
+		}
+	case *Test_Three:
+		return false
+	case *Test_Four:
+		return false
+	}
+	if d, ok := interface{}(x.Descriptor_).(interface{ IsDefault() bool }); ok && x.Descriptor_ != nil && !d.IsDefault() {
+		return false
+	}
+	if x.TimeValueFieldWithDefault != nil && !proto.Equal(x.TimeValueFieldWithDefault, &timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}) {
+		return false
+	}
+	if len(x.Bytes) != 0 && string(x.Bytes) != "??" {
+		return false
 	}
+	return true
 }
 
+func NewTest() *Test {
+	x := &Test{}
+	x.Default()
+	return x
+}
+
+func NewTestWith(fn func(x *Test)) *Test {
+	x := &Test{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	TestOptional_Default_StringField string            = "string_field"
+	TestOptional_Default_NumberField int64             = 42
+	TestOptional_Default_BoolField   bool              = true
+	TestOptional_Default_EnumField   TestOptional_Type = 2
+)
+
 func (x *TestOptional) Default() {
+	if x.StringField == nil {
+		v := string(TestOptional_Default_StringField)
+		x.StringField = &v
+	}
+	if x.NumberField == nil {
+		v := int64(TestOptional_Default_NumberField)
+		x.NumberField = &v
+	}
+	if x.BoolField == nil {
+		v := bool(TestOptional_Default_BoolField)
+		x.BoolField = &v
+	}
+	if x.EnumField == nil {
+		v := TestOptional_Type(TestOptional_Default_EnumField)
+		x.EnumField = &v
+	}
+}
+
+func (x *TestOptional) DefaultE() error {
+	var errs defaults.Errors
+	if x.StringField == nil {
+		v := string(TestOptional_Default_StringField)
+		x.StringField = &v
+	}
+	if x.NumberField == nil {
+		v := int64(TestOptional_Default_NumberField)
+		x.NumberField = &v
+	}
+	if x.BoolField == nil {
+		v := bool(TestOptional_Default_BoolField)
+		x.BoolField = &v
+	}
+	if x.EnumField == nil {
+		v := TestOptional_Type(TestOptional_Default_EnumField)
+		x.EnumField = &v
+	}
+	return errs.Err()
+}
+
+func (x *TestOptional) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.StringField == nil {
+		v := string(TestOptional_Default_StringField)
+		x.StringField = &v
+		paths = append(paths, "string_field")
+	}
+	if x.NumberField == nil {
+		v := int64(TestOptional_Default_NumberField)
+		x.NumberField = &v
+		paths = append(paths, "number_field")
+	}
+	if x.BoolField == nil {
+		v := bool(TestOptional_Default_BoolField)
+		x.BoolField = &v
+		paths = append(paths, "bool_field")
+	}
+	if x.EnumField == nil {
+		v := TestOptional_Type(TestOptional_Default_EnumField)
+		x.EnumField = &v
+		paths = append(paths, "enum_field")
+	}
+	return paths
+}
+
+func (x *TestOptional) StripDefaults() {
+	if x.EnumField != nil && *x.EnumField == TestOptional_Type(2) {
+		x.EnumField = nil
+	}
+	if x.BoolField != nil && *x.BoolField == true {
+		x.BoolField = nil
+	}
+	if x.NumberField != nil && *x.NumberField == 42 {
+		x.NumberField = nil
+	}
+	if x.StringField != nil && *x.StringField == "string_field" {
+		x.StringField = nil
+	}
+}
+
+func (x *TestOptional) IsDefault() bool {
+	if x.StringField != nil && *x.StringField != "string_field" {
+		return false
+	}
+	if x.NumberField != nil && *x.NumberField != 42 {
+		return false
+	}
+	if x.BoolField != nil && *x.BoolField != true {
+		return false
+	}
+	if x.EnumField != nil && *x.EnumField != TestOptional_Type(2) {
+		return false
+	}
+	return true
+}
+
+func (x *TestUnexported) _Default() {
 	if x.StringField == nil {
 		v := string("string_field")
 		x.StringField = &v
@@ -119,26 +560,99 @@ func (x *TestOptional) Default() {
 		x.BoolField = &v
 	}
 	if x.EnumField == nil {
-		v := TestOptional_Type(2)
+		v := TestUnexported_Type(2)
 		x.EnumField = &v
 	}
 }
 
-func (x *TestUnexported) _Default() {
+func (x *TestUnexported) _DefaultE() error {
+	var errs defaults.Errors
+	if x.StringField == nil {
+		v := string("string_field")
+		x.StringField = &v
+	}
+	if x.NumberField == nil {
+		v := int64(42)
+		x.NumberField = &v
+	}
+	if x.BoolField == nil {
+		v := bool(true)
+		x.BoolField = &v
+	}
+	if x.EnumField == nil {
+		v := TestUnexported_Type(2)
+		x.EnumField = &v
+	}
+	return errs.Err()
+}
+
+func (x *TestUnexported) _DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
 	if x.StringField == nil {
 		v := string("string_field")
 		x.StringField = &v
+		paths = append(paths, "string_field")
 	}
 	if x.NumberField == nil {
 		v := int64(42)
 		x.NumberField = &v
+		paths = append(paths, "number_field")
 	}
 	if x.BoolField == nil {
 		v := bool(true)
 		x.BoolField = &v
+		paths = append(paths, "bool_field")
 	}
 	if x.EnumField == nil {
 		v := TestUnexported_Type(2)
 		x.EnumField = &v
+		paths = append(paths, "enum_field")
+	}
+	return paths
+}
+
+func (x *TestUnexported) _StripDefaults() {
+	if x.EnumField != nil && *x.EnumField == TestUnexported_Type(2) {
+		x.EnumField = nil
+	}
+	if x.BoolField != nil && *x.BoolField == true {
+		x.BoolField = nil
+	}
+	if x.NumberField != nil && *x.NumberField == 42 {
+		x.NumberField = nil
+	}
+	if x.StringField != nil && *x.StringField == "string_field" {
+		x.StringField = nil
+	}
+}
+
+func (x *TestUnexported) _IsDefault() bool {
+	if x.StringField != nil && *x.StringField != "string_field" {
+		return false
+	}
+	if x.NumberField != nil && *x.NumberField != 42 {
+		return false
+	}
+	if x.BoolField != nil && *x.BoolField != true {
+		return false
+	}
+	if x.EnumField != nil && *x.EnumField != TestUnexported_Type(2) {
+		return false
+	}
+	return true
+}
+
+func _NewTestUnexported() *TestUnexported {
+	x := &TestUnexported{}
+	x._Default()
+	return x
+}
+
+func _NewTestUnexportedWith(fn func(x *TestUnexported)) *TestUnexported {
+	x := &TestUnexported{}
+	if fn != nil {
+		fn(x)
 	}
+	x._Default()
+	return x
 }
@@ -17,65 +17,128 @@
 package pb
 
 import (
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
 )
 
 var (
 	_ *timestamppb.Timestamp
 	_ *durationpb.Duration
 	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Types_Default_Float            float32       = 0.42
+	Types_Default_Double           float64       = 0.42
+	Types_Default_Int32            int32         = 42
+	Types_Default_Int64            int64         = 42
+	Types_Default_Uint32           uint32        = 42
+	Types_Default_Uint64           uint64        = 42
+	Types_Default_Sint32           int32         = 42
+	Types_Default_Sint64           int64         = 42
+	Types_Default_Fixed32          uint32        = 42
+	Types_Default_Fixed64          uint64        = 42
+	Types_Default_Sfixed32         int32         = 42
+	Types_Default_Sfixed64         int64         = 42
+	Types_Default_Bool             bool          = true
+	Types_Default_String_          string        = "42"
+	Types_Default_Enum             Types_Enum    = 1
+	Types_Default_EnumName         Types_Enum    = Types_TWO
+	Types_Default_EnumFullName     Types_Enum    = Types_NEGATIVE
+	Types_Default_OptionalEnumName Types_Enum    = Types_NEGATIVE
+	Types_Default_Four             Types_Enum    = 1
+	Types_Default_Duration         time.Duration = 172800000000000
+	Types_Default_DoubleValue      float64       = 0.42
+	Types_Default_FloatValue       float32       = 0.42
+	Types_Default_Int64Value       int64         = 42
+	Types_Default_Uint64Value      uint64        = 42
+	Types_Default_Int32Value       int32         = 42
+	Types_Default_Uint32Value      uint32        = 42
+	Types_Default_BoolValue        bool          = false
+	Types_Default_StringValue      string        = "42"
+)
+
+var (
+	Types_Default_Bytes      = []byte("42")
+	Types_Default_BytesValue = []byte("42")
+	Types_Default_Any        = &anypb.Any{TypeUrl: "type.googleapis.com/tests.Message", Value: []byte("\n\x06packed")}
+	Types_Default_AnyJson    = &anypb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Duration", Value: []byte("\b\x01")}
+	Types_Default_Struct     = &structpb.Struct{Fields: map[string]*structpb.Value{"bool": structpb.NewBoolValue(true), "list": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewStringValue("two")}}), "null": structpb.NewNullValue(), "number": structpb.NewNumberValue(42), "string": structpb.NewStringValue("value"), "struct": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}})}}
+	Types_Default_Value      = structpb.NewStringValue("value")
+	Types_Default_ListValue  = &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(0.42), structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewBoolValue(false)}})}}
 )
 
 func (x *Types) Default() {
 	if x.Float == 0 {
-		x.Float = 0.42
+		x.Float = Types_Default_Float
 	}
 	if x.Double == 0 {
-		x.Double = 0.42
+		x.Double = Types_Default_Double
 	}
 	if x.Int32 == 0 {
-		x.Int32 = 42
+		x.Int32 = Types_Default_Int32
 	}
 	if x.Int64 == 0 {
-		x.Int64 = 42
+		x.Int64 = Types_Default_Int64
 	}
 	if x.Uint32 == 0 {
-		x.Uint32 = 42
+		x.Uint32 = Types_Default_Uint32
 	}
 	if x.Uint64 == 0 {
-		x.Uint64 = 42
+		x.Uint64 = Types_Default_Uint64
 	}
 	if x.Sint32 == 0 {
-		x.Sint32 = 42
+		x.Sint32 = Types_Default_Sint32
 	}
 	if x.Sint64 == 0 {
-		x.Sint64 = 42
+		x.Sint64 = Types_Default_Sint64
 	}
 	if x.Fixed32 == 0 {
-		x.Fixed32 = 42
+		x.Fixed32 = Types_Default_Fixed32
 	}
 	if x.Fixed64 == 0 {
-		x.Fixed64 = 0
+		x.Fixed64 = Types_Default_Fixed64
 	}
 	if x.Sfixed32 == 0 {
-		x.Sfixed32 = 42
+		x.Sfixed32 = Types_Default_Sfixed32
 	}
 	if x.Sfixed64 == 0 {
-		x.Sfixed64 = 42
+		x.Sfixed64 = Types_Default_Sfixed64
 	}
 	if x.Bool == false {
-		x.Bool = true
+		x.Bool = Types_Default_Bool
 	}
 	if x.String_ == "" {
-		x.String_ = "42"
+		x.String_ = Types_Default_String_
 	}
 	if len(x.Bytes) == 0 {
-		x.Bytes = []byte("42")
+		x.Bytes = append([]byte(nil), Types_Default_Bytes...)
 	}
 	if x.Enum == 0 {
-		x.Enum = 1
+		x.Enum = Types_Default_Enum
+	}
+	if x.EnumName == 0 {
+		x.EnumName = Types_Default_EnumName
+	}
+	if x.EnumFullName == 0 {
+		x.EnumFullName = Types_Default_EnumFullName
+	}
+	if x.OptionalEnumName == nil {
+		v := Types_Enum(Types_Default_OptionalEnumName)
+		x.OptionalEnumName = &v
+	}
+	if x.Message == nil {
+		x.Message = &Message{}
 	}
 	// Message: defaults disabled by [(defaults.value).message = {defaults: false}]
 	if x.Oneof == nil {
@@ -105,55 +168,2875 @@ func (x *Types) Default() {
 		}
 	case *Types_Four:
 		if x.Four == 0 {
-			x.Four = 1
+			x.Four = Types_Default_Four
 		}
 	}
 	if x.Duration == nil {
-		x.Duration = durationpb.New(172800000000000)
+		x.Duration = durationpb.New(Types_Default_Duration)
 	}
 	if x.Timestamp == nil {
 		x.Timestamp = timestamppb.Now()
 	}
 	if x.DoubleValue == nil {
-		x.DoubleValue = &wrapperspb.DoubleValue{Value: 0.42}
+		x.DoubleValue = &wrapperspb.DoubleValue{Value: Types_Default_DoubleValue}
 	}
 	if x.FloatValue == nil {
-		x.FloatValue = &wrapperspb.FloatValue{Value: 0.42}
+		x.FloatValue = &wrapperspb.FloatValue{Value: Types_Default_FloatValue}
 	}
 	if x.Int64Value == nil {
-		x.Int64Value = &wrapperspb.Int64Value{Value: 42}
+		x.Int64Value = &wrapperspb.Int64Value{Value: Types_Default_Int64Value}
 	}
 	if x.Uint64Value == nil {
-		x.Uint64Value = &wrapperspb.UInt64Value{Value: 42}
+		x.Uint64Value = &wrapperspb.UInt64Value{Value: Types_Default_Uint64Value}
 	}
 	if x.Int32Value == nil {
-		x.Int32Value = &wrapperspb.Int32Value{Value: 42}
+		x.Int32Value = &wrapperspb.Int32Value{Value: Types_Default_Int32Value}
 	}
 	if x.Uint32Value == nil {
-		x.Uint32Value = &wrapperspb.UInt32Value{Value: 42}
+		x.Uint32Value = &wrapperspb.UInt32Value{Value: Types_Default_Uint32Value}
 	}
 	if x.BoolValue == nil {
-		x.BoolValue = &wrapperspb.BoolValue{Value: false}
+		x.BoolValue = &wrapperspb.BoolValue{Value: Types_Default_BoolValue}
 	}
 	if x.StringValue == nil {
-		x.StringValue = &wrapperspb.StringValue{Value: "42"}
+		x.StringValue = &wrapperspb.StringValue{Value: Types_Default_StringValue}
 	}
 	if x.BytesValue == nil {
-		x.BytesValue = &wrapperspb.BytesValue{Value: []byte("42")}
+		x.BytesValue = &wrapperspb.BytesValue{Value: append([]byte(nil), Types_Default_BytesValue...)}
+	}
+	if x.Any == nil {
+		x.Any = proto.Clone(Types_Default_Any).(*anypb.Any)
+	}
+	if x.AnyJson == nil {
+		x.AnyJson = proto.Clone(Types_Default_AnyJson).(*anypb.Any)
+	}
+	if x.Struct == nil {
+		x.Struct = proto.Clone(Types_Default_Struct).(*structpb.Struct)
+	}
+	if x.Value == nil {
+		x.Value = proto.Clone(Types_Default_Value).(*structpb.Value)
+	}
+	if x.ListValue == nil {
+		x.ListValue = proto.Clone(Types_Default_ListValue).(*structpb.ListValue)
 	}
 }
 
-func (x *Message) Default() {
-	if x.Field == "" {
-		x.Field = "lonely field"
+func (x *Types) DefaultE() error {
+	var errs defaults.Errors
+	if x.Float == 0 {
+		x.Float = Types_Default_Float
+	}
+	if x.Double == 0 {
+		x.Double = Types_Default_Double
+	}
+	if x.Int32 == 0 {
+		x.Int32 = Types_Default_Int32
+	}
+	if x.Int64 == 0 {
+		x.Int64 = Types_Default_Int64
+	}
+	if x.Uint32 == 0 {
+		x.Uint32 = Types_Default_Uint32
+	}
+	if x.Uint64 == 0 {
+		x.Uint64 = Types_Default_Uint64
+	}
+	if x.Sint32 == 0 {
+		x.Sint32 = Types_Default_Sint32
+	}
+	if x.Sint64 == 0 {
+		x.Sint64 = Types_Default_Sint64
+	}
+	if x.Fixed32 == 0 {
+		x.Fixed32 = Types_Default_Fixed32
+	}
+	if x.Fixed64 == 0 {
+		x.Fixed64 = Types_Default_Fixed64
+	}
+	if x.Sfixed32 == 0 {
+		x.Sfixed32 = Types_Default_Sfixed32
+	}
+	if x.Sfixed64 == 0 {
+		x.Sfixed64 = Types_Default_Sfixed64
+	}
+	if x.Bool == false {
+		x.Bool = Types_Default_Bool
+	}
+	if x.String_ == "" {
+		x.String_ = Types_Default_String_
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = append([]byte(nil), Types_Default_Bytes...)
+	}
+	if x.Enum == 0 {
+		x.Enum = Types_Default_Enum
+	}
+	if x.EnumName == 0 {
+		x.EnumName = Types_Default_EnumName
+	}
+	if x.EnumFullName == 0 {
+		x.EnumFullName = Types_Default_EnumFullName
+	}
+	if x.OptionalEnumName == nil {
+		v := Types_Enum(Types_Default_OptionalEnumName)
+		x.OptionalEnumName = &v
+	}
+	if x.Message == nil {
+		x.Message = &Message{}
+	}
+	// Message: defaults disabled by [(defaults.value).message = {defaults: false}]
+	if x.Oneof == nil {
+		x.Oneof = &Types_Two{}
+	}
+	switch x := x.Oneof.(type) {
+	case *Types_One:
+		if x.One == nil {
+			x.One = &OneOfOne{}
+		}
+		if v, ok := interface{}(x.One).(interface{ DefaultE() error }); ok && x.One != nil {
+			errs.Add("one", v.DefaultE())
+		} else if v, ok := interface{}(x.One).(interface{ Default() }); ok && x.One != nil {
+			v.Default()
+		}
+	case *Types_Two:
+		if x.Two == nil {
+			x.Two = &OneOfTwo{}
+		}
+		if v, ok := interface{}(x.Two).(interface{ DefaultE() error }); ok && x.Two != nil {
+			errs.Add("two", v.DefaultE())
+		} else if v, ok := interface{}(x.Two).(interface{ Default() }); ok && x.Two != nil {
+			v.Default()
+		}
+	case *Types_Three:
+		if x.Three == nil {
+			x.Three = &OneOfThree{}
+		}
+		if v, ok := interface{}(x.Three).(interface{ DefaultE() error }); ok && x.Three != nil {
+			errs.Add("three", v.DefaultE())
+		} else if v, ok := interface{}(x.Three).(interface{ Default() }); ok && x.Three != nil {
+			v.Default()
+		}
+	case *Types_Four:
+		if x.Four == 0 {
+			x.Four = Types_Default_Four
+		}
+	}
+	if x.Duration == nil {
+		x.Duration = durationpb.New(Types_Default_Duration)
+	}
+	if x.Timestamp == nil {
+		x.Timestamp = timestamppb.Now()
+	}
+	if x.DoubleValue == nil {
+		x.DoubleValue = &wrapperspb.DoubleValue{Value: Types_Default_DoubleValue}
+	}
+	if x.FloatValue == nil {
+		x.FloatValue = &wrapperspb.FloatValue{Value: Types_Default_FloatValue}
+	}
+	if x.Int64Value == nil {
+		x.Int64Value = &wrapperspb.Int64Value{Value: Types_Default_Int64Value}
+	}
+	if x.Uint64Value == nil {
+		x.Uint64Value = &wrapperspb.UInt64Value{Value: Types_Default_Uint64Value}
+	}
+	if x.Int32Value == nil {
+		x.Int32Value = &wrapperspb.Int32Value{Value: Types_Default_Int32Value}
 	}
+	if x.Uint32Value == nil {
+		x.Uint32Value = &wrapperspb.UInt32Value{Value: Types_Default_Uint32Value}
+	}
+	if x.BoolValue == nil {
+		x.BoolValue = &wrapperspb.BoolValue{Value: Types_Default_BoolValue}
+	}
+	if x.StringValue == nil {
+		x.StringValue = &wrapperspb.StringValue{Value: Types_Default_StringValue}
+	}
+	if x.BytesValue == nil {
+		x.BytesValue = &wrapperspb.BytesValue{Value: append([]byte(nil), Types_Default_BytesValue...)}
+	}
+	if x.Any == nil {
+		x.Any = proto.Clone(Types_Default_Any).(*anypb.Any)
+	}
+	if x.AnyJson == nil {
+		x.AnyJson = proto.Clone(Types_Default_AnyJson).(*anypb.Any)
+	}
+	if x.Struct == nil {
+		x.Struct = proto.Clone(Types_Default_Struct).(*structpb.Struct)
+	}
+	if x.Value == nil {
+		x.Value = proto.Clone(Types_Default_Value).(*structpb.Value)
+	}
+	if x.ListValue == nil {
+		x.ListValue = proto.Clone(Types_Default_ListValue).(*structpb.ListValue)
+	}
+	return errs.Err()
 }
 
-func (x *OneOfTwo) Default() {
-	if x.StringField == "" {
-		x.StringField = "string_field"
+func (x *Types) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Float == 0 {
+		x.Float = Types_Default_Float
+		paths = append(paths, "float")
+	}
+	if x.Double == 0 {
+		x.Double = Types_Default_Double
+		paths = append(paths, "double")
+	}
+	if x.Int32 == 0 {
+		x.Int32 = Types_Default_Int32
+		paths = append(paths, "int32")
+	}
+	if x.Int64 == 0 {
+		x.Int64 = Types_Default_Int64
+		paths = append(paths, "int64")
+	}
+	if x.Uint32 == 0 {
+		x.Uint32 = Types_Default_Uint32
+		paths = append(paths, "uint32")
+	}
+	if x.Uint64 == 0 {
+		x.Uint64 = Types_Default_Uint64
+		paths = append(paths, "uint64")
+	}
+	if x.Sint32 == 0 {
+		x.Sint32 = Types_Default_Sint32
+		paths = append(paths, "sint32")
+	}
+	if x.Sint64 == 0 {
+		x.Sint64 = Types_Default_Sint64
+		paths = append(paths, "sint64")
+	}
+	if x.Fixed32 == 0 {
+		x.Fixed32 = Types_Default_Fixed32
+		paths = append(paths, "fixed32")
 	}
+	if x.Fixed64 == 0 {
+		x.Fixed64 = Types_Default_Fixed64
+		paths = append(paths, "fixed64")
+	}
+	if x.Sfixed32 == 0 {
+		x.Sfixed32 = Types_Default_Sfixed32
+		paths = append(paths, "sfixed32")
+	}
+	if x.Sfixed64 == 0 {
+		x.Sfixed64 = Types_Default_Sfixed64
+		paths = append(paths, "sfixed64")
+	}
+	if x.Bool == false {
+		x.Bool = Types_Default_Bool
+		paths = append(paths, "bool")
+	}
+	if x.String_ == "" {
+		x.String_ = Types_Default_String_
+		paths = append(paths, "string")
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = append([]byte(nil), Types_Default_Bytes...)
+		paths = append(paths, "bytes")
+	}
+	if x.Enum == 0 {
+		x.Enum = Types_Default_Enum
+		paths = append(paths, "enum")
+	}
+	if x.EnumName == 0 {
+		x.EnumName = Types_Default_EnumName
+		paths = append(paths, "enum_name")
+	}
+	if x.EnumFullName == 0 {
+		x.EnumFullName = Types_Default_EnumFullName
+		paths = append(paths, "enum_full_name")
+	}
+	if x.OptionalEnumName == nil {
+		v := Types_Enum(Types_Default_OptionalEnumName)
+		x.OptionalEnumName = &v
+		paths = append(paths, "optional_enum_name")
+	}
+	if x.Message == nil {
+		x.Message = &Message{}
+		paths = append(paths, "message")
+	}
+	// Message: defaults disabled by [(defaults.value).message = {defaults: false}]
+	if x.Oneof == nil {
+		x.Oneof = &Types_Two{}
+	}
+	switch x := x.Oneof.(type) {
+	case *Types_One:
+		if x.One == nil {
+			x.One = &OneOfOne{}
+			paths = append(paths, "one")
+		}
+		if v, ok := interface{}(x.One).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.One != nil {
+			paths = append(paths, defaults.PrefixPaths("one", v.DefaultReport())...)
+		} else if v, ok := interface{}(x.One).(interface{ Default() }); ok && x.One != nil {
+			v.Default()
+		}
+	case *Types_Two:
+		if x.Two == nil {
+			x.Two = &OneOfTwo{}
+			paths = append(paths, "two")
+		}
+		if v, ok := interface{}(x.Two).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Two != nil {
+			paths = append(paths, defaults.PrefixPaths("two", v.DefaultReport())...)
+		} else if v, ok := interface{}(x.Two).(interface{ Default() }); ok && x.Two != nil {
+			v.Default()
+		}
+	case *Types_Three:
+		if x.Three == nil {
+			x.Three = &OneOfThree{}
+			paths = append(paths, "three")
+		}
+		if v, ok := interface{}(x.Three).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Three != nil {
+			paths = append(paths, defaults.PrefixPaths("three", v.DefaultReport())...)
+		} else if v, ok := interface{}(x.Three).(interface{ Default() }); ok && x.Three != nil {
+			v.Default()
+		}
+	case *Types_Four:
+		if x.Four == 0 {
+			x.Four = Types_Default_Four
+			paths = append(paths, "four")
+		}
+	}
+	if x.Duration == nil {
+		x.Duration = durationpb.New(Types_Default_Duration)
+		paths = append(paths, "duration")
+	}
+	if x.Timestamp == nil {
+		x.Timestamp = timestamppb.Now()
+		paths = append(paths, "timestamp")
+	}
+	if x.DoubleValue == nil {
+		x.DoubleValue = &wrapperspb.DoubleValue{Value: Types_Default_DoubleValue}
+		paths = append(paths, "double_value")
+	}
+	if x.FloatValue == nil {
+		x.FloatValue = &wrapperspb.FloatValue{Value: Types_Default_FloatValue}
+		paths = append(paths, "float_value")
+	}
+	if x.Int64Value == nil {
+		x.Int64Value = &wrapperspb.Int64Value{Value: Types_Default_Int64Value}
+		paths = append(paths, "int64_value")
+	}
+	if x.Uint64Value == nil {
+		x.Uint64Value = &wrapperspb.UInt64Value{Value: Types_Default_Uint64Value}
+		paths = append(paths, "uint64_value")
+	}
+	if x.Int32Value == nil {
+		x.Int32Value = &wrapperspb.Int32Value{Value: Types_Default_Int32Value}
+		paths = append(paths, "int32_value")
+	}
+	if x.Uint32Value == nil {
+		x.Uint32Value = &wrapperspb.UInt32Value{Value: Types_Default_Uint32Value}
+		paths = append(paths, "uint32_value")
+	}
+	if x.BoolValue == nil {
+		x.BoolValue = &wrapperspb.BoolValue{Value: Types_Default_BoolValue}
+		paths = append(paths, "bool_value")
+	}
+	if x.StringValue == nil {
+		x.StringValue = &wrapperspb.StringValue{Value: Types_Default_StringValue}
+		paths = append(paths, "string_value")
+	}
+	if x.BytesValue == nil {
+		x.BytesValue = &wrapperspb.BytesValue{Value: append([]byte(nil), Types_Default_BytesValue...)}
+		paths = append(paths, "bytes_value")
+	}
+	if x.Any == nil {
+		x.Any = proto.Clone(Types_Default_Any).(*anypb.Any)
+		paths = append(paths, "any")
+	}
+	if x.AnyJson == nil {
+		x.AnyJson = proto.Clone(Types_Default_AnyJson).(*anypb.Any)
+		paths = append(paths, "any_json")
+	}
+	if x.Struct == nil {
+		x.Struct = proto.Clone(Types_Default_Struct).(*structpb.Struct)
+		paths = append(paths, "struct")
+	}
+	if x.Value == nil {
+		x.Value = proto.Clone(Types_Default_Value).(*structpb.Value)
+		paths = append(paths, "value")
+	}
+	if x.ListValue == nil {
+		x.ListValue = proto.Clone(Types_Default_ListValue).(*structpb.ListValue)
+		paths = append(paths, "list_value")
+	}
+	return paths
 }
 
-func (x *OneOfThree) Default() {
+func (x *Types) StripDefaults() {
+	if x.ListValue != nil && proto.Equal(x.ListValue, &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(0.42), structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewBoolValue(false)}})}}) {
+		x.ListValue = nil
+	}
+	if x.Value != nil && proto.Equal(x.Value, structpb.NewStringValue("value")) {
+		x.Value = nil
+	}
+	if x.Struct != nil && proto.Equal(x.Struct, &structpb.Struct{Fields: map[string]*structpb.Value{"bool": structpb.NewBoolValue(true), "list": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewStringValue("two")}}), "null": structpb.NewNullValue(), "number": structpb.NewNumberValue(42), "string": structpb.NewStringValue("value"), "struct": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}})}}) {
+		x.Struct = nil
+	}
+	if x.AnyJson != nil && proto.Equal(x.AnyJson, &anypb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Duration", Value: []byte("\b\x01")}) {
+		x.AnyJson = nil
+	}
+	if x.Any != nil && proto.Equal(x.Any, &anypb.Any{TypeUrl: "type.googleapis.com/tests.Message", Value: []byte("\n\x06packed")}) {
+		x.Any = nil
+	}
+	if x.BytesValue != nil && string(x.BytesValue.Value) == "42" {
+		x.BytesValue = nil
+	}
+	if x.StringValue != nil && proto.Equal(x.StringValue, &wrapperspb.StringValue{Value: "42"}) {
+		x.StringValue = nil
+	}
+	if x.BoolValue != nil && proto.Equal(x.BoolValue, &wrapperspb.BoolValue{Value: false}) {
+		x.BoolValue = nil
+	}
+	if x.Uint32Value != nil && proto.Equal(x.Uint32Value, &wrapperspb.UInt32Value{Value: 42}) {
+		x.Uint32Value = nil
+	}
+	if x.Int32Value != nil && proto.Equal(x.Int32Value, &wrapperspb.Int32Value{Value: 42}) {
+		x.Int32Value = nil
+	}
+	if x.Uint64Value != nil && proto.Equal(x.Uint64Value, &wrapperspb.UInt64Value{Value: 42}) {
+		x.Uint64Value = nil
+	}
+	if x.Int64Value != nil && proto.Equal(x.Int64Value, &wrapperspb.Int64Value{Value: 42}) {
+		x.Int64Value = nil
+	}
+	if x.FloatValue != nil && proto.Equal(x.FloatValue, &wrapperspb.FloatValue{Value: 0.42}) {
+		x.FloatValue = nil
+	}
+	if x.DoubleValue != nil && proto.Equal(x.DoubleValue, &wrapperspb.DoubleValue{Value: 0.42}) {
+		x.DoubleValue = nil
+	}
+	if x.Duration != nil && proto.Equal(x.Duration, durationpb.New(172800000000000)) {
+		x.Duration = nil
+	}
+	switch o := x.Oneof.(type) {
+	case *Types_One:
+		if s, ok := interface{}(o.One).(interface{ StripDefaults() }); ok && o.One != nil {
+			s.StripDefaults()
+		}
+	case *Types_Two:
+		if s, ok := interface{}(o.Two).(interface{ StripDefaults() }); ok && o.Two != nil {
+			s.StripDefaults()
+		}
+		if o.Two != nil && proto.Size(o.Two) == 0 {
+			x.Oneof = nil
+		}
+	case *Types_Three:
+		if s, ok := interface{}(o.Three).(interface{ StripDefaults() }); ok && o.Three != nil {
+			s.StripDefaults()
+		}
+	}
+	if x.Message != nil && proto.Size(x.Message) == 0 {
+		x.Message = nil
+	}
+	if x.OptionalEnumName != nil && *x.OptionalEnumName == Types_NEGATIVE {
+		x.OptionalEnumName = nil
+	}
+	if x.EnumFullName == Types_NEGATIVE {
+		x.EnumFullName = 0
+	}
+	if x.EnumName == Types_TWO {
+		x.EnumName = 0
+	}
+	if x.Enum == Types_Enum(1) {
+		x.Enum = 0
+	}
+	if string(x.Bytes) == "42" {
+		x.Bytes = nil
+	}
+	if x.String_ == "42" {
+		x.String_ = ""
+	}
+	if x.Bool == true {
+		x.Bool = false
+	}
+	if x.Sfixed64 == 42 {
+		x.Sfixed64 = 0
+	}
+	if x.Sfixed32 == 42 {
+		x.Sfixed32 = 0
+	}
+	if x.Fixed64 == 42 {
+		x.Fixed64 = 0
+	}
+	if x.Fixed32 == 42 {
+		x.Fixed32 = 0
+	}
+	if x.Sint64 == 42 {
+		x.Sint64 = 0
+	}
+	if x.Sint32 == 42 {
+		x.Sint32 = 0
+	}
+	if x.Uint64 == 42 {
+		x.Uint64 = 0
+	}
+	if x.Uint32 == 42 {
+		x.Uint32 = 0
+	}
+	if x.Int64 == 42 {
+		x.Int64 = 0
+	}
+	if x.Int32 == 42 {
+		x.Int32 = 0
+	}
+	if x.Double == 0.42 {
+		x.Double = 0
+	}
+	if x.Float == 0.42 {
+		x.Float = 0
+	}
+}
+
+func (x *Types) IsDefault() bool {
+	if x.Float != 0 && x.Float != 0.42 {
+		return false
+	}
+	if x.Double != 0 && x.Double != 0.42 {
+		return false
+	}
+	if x.Int32 != 0 && x.Int32 != 42 {
+		return false
+	}
+	if x.Int64 != 0 && x.Int64 != 42 {
+		return false
+	}
+	if x.Uint32 != 0 && x.Uint32 != 42 {
+		return false
+	}
+	if x.Uint64 != 0 && x.Uint64 != 42 {
+		return false
+	}
+	if x.Sint32 != 0 && x.Sint32 != 42 {
+		return false
+	}
+	if x.Sint64 != 0 && x.Sint64 != 42 {
+		return false
+	}
+	if x.Fixed32 != 0 && x.Fixed32 != 42 {
+		return false
+	}
+	if x.Fixed64 != 0 && x.Fixed64 != 42 {
+		return false
+	}
+	if x.Sfixed32 != 0 && x.Sfixed32 != 42 {
+		return false
+	}
+	if x.Sfixed64 != 0 && x.Sfixed64 != 42 {
+		return false
+	}
+	if x.Bool != false && x.Bool != true {
+		return false
+	}
+	if x.String_ != "" && x.String_ != "42" {
+		return false
+	}
+	if len(x.Bytes) != 0 && string(x.Bytes) != "42" {
+		return false
+	}
+	if x.Enum != 0 && x.Enum != Types_Enum(1) {
+		return false
+	}
+	if x.EnumName != 0 && x.EnumName != Types_TWO {
+		return false
+	}
+	if x.EnumFullName != 0 && x.EnumFullName != Types_NEGATIVE {
+		return false
+	}
+	if x.OptionalEnumName != nil && *x.OptionalEnumName != Types_NEGATIVE {
+		return false
+	}
+	if x.Message != nil && proto.Size(x.Message) != 0 {
+		return false
+	}
+	switch o := x.Oneof.(type) {
+	case *Types_One:
+		return false
+	case *Types_Two:
+		if d, ok := interface{}(o.Two).(interface{ IsDefault() bool }); ok && o.Two != nil && !d.IsDefault() {
+			return false
+		}
+	case *Types_Three:
+		return false
+	case *Types_Four:
+		return false
+	}
+	if x.Duration != nil && !proto.Equal(x.Duration, durationpb.New(172800000000000)) {
+		return false
+	}
+	if x.DoubleValue != nil && !proto.Equal(x.DoubleValue, &wrapperspb.DoubleValue{Value: 0.42}) {
+		return false
+	}
+	if x.FloatValue != nil && !proto.Equal(x.FloatValue, &wrapperspb.FloatValue{Value: 0.42}) {
+		return false
+	}
+	if x.Int64Value != nil && !proto.Equal(x.Int64Value, &wrapperspb.Int64Value{Value: 42}) {
+		return false
+	}
+	if x.Uint64Value != nil && !proto.Equal(x.Uint64Value, &wrapperspb.UInt64Value{Value: 42}) {
+		return false
+	}
+	if x.Int32Value != nil && !proto.Equal(x.Int32Value, &wrapperspb.Int32Value{Value: 42}) {
+		return false
+	}
+	if x.Uint32Value != nil && !proto.Equal(x.Uint32Value, &wrapperspb.UInt32Value{Value: 42}) {
+		return false
+	}
+	if x.BoolValue != nil && !proto.Equal(x.BoolValue, &wrapperspb.BoolValue{Value: false}) {
+		return false
+	}
+	if x.StringValue != nil && !proto.Equal(x.StringValue, &wrapperspb.StringValue{Value: "42"}) {
+		return false
+	}
+	if x.BytesValue != nil && string(x.BytesValue.Value) != "42" {
+		return false
+	}
+	if x.Any != nil && !proto.Equal(x.Any, &anypb.Any{TypeUrl: "type.googleapis.com/tests.Message", Value: []byte("\n\x06packed")}) {
+		return false
+	}
+	if x.AnyJson != nil && !proto.Equal(x.AnyJson, &anypb.Any{TypeUrl: "type.googleapis.com/google.protobuf.Duration", Value: []byte("\b\x01")}) {
+		return false
+	}
+	if x.Struct != nil && !proto.Equal(x.Struct, &structpb.Struct{Fields: map[string]*structpb.Value{"bool": structpb.NewBoolValue(true), "list": structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(1), structpb.NewStringValue("two")}}), "null": structpb.NewNullValue(), "number": structpb.NewNumberValue(42), "string": structpb.NewStringValue("value"), "struct": structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewStringValue("value")}})}}) {
+		return false
+	}
+	if x.Value != nil && !proto.Equal(x.Value, structpb.NewStringValue("value")) {
+		return false
+	}
+	if x.ListValue != nil && !proto.Equal(x.ListValue, &structpb.ListValue{Values: []*structpb.Value{structpb.NewNumberValue(0.42), structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{"key": structpb.NewBoolValue(false)}})}}) {
+		return false
+	}
+	return true
+}
+
+func NewTypes() *Types {
+	x := &Types{}
+	x.Default()
+	return x
+}
+
+func NewTypesWith(fn func(x *Types)) *Types {
+	x := &Types{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	Message_Default_Field string = "lonely field"
+)
+
+func (x *Message) Default() {
+	if x.Field == "" {
+		x.Field = Message_Default_Field
+	}
+}
+
+func (x *Message) DefaultE() error {
+	var errs defaults.Errors
+	if x.Field == "" {
+		x.Field = Message_Default_Field
+	}
+	return errs.Err()
+}
+
+func (x *Message) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Field == "" {
+		x.Field = Message_Default_Field
+		paths = append(paths, "field")
+	}
+	return paths
+}
+
+func (x *Message) StripDefaults() {
+	if x.Field == "lonely field" {
+		x.Field = ""
+	}
+}
+
+func (x *Message) IsDefault() bool {
+	if x.Field != "" && x.Field != "lonely field" {
+		return false
+	}
+	return true
+}
+
+func NewMessage() *Message {
+	x := &Message{}
+	x.Default()
+	return x
+}
+
+func NewMessageWith(fn func(x *Message)) *Message {
+	x := &Message{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	OneOfTwo_Default_StringField string = "string_field"
+)
+
+func (x *OneOfTwo) Default() {
+	if x.StringField == "" {
+		x.StringField = OneOfTwo_Default_StringField
+	}
+}
+
+func (x *OneOfTwo) DefaultE() error {
+	var errs defaults.Errors
+	if x.StringField == "" {
+		x.StringField = OneOfTwo_Default_StringField
+	}
+	return errs.Err()
+}
+
+func (x *OneOfTwo) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.StringField == "" {
+		x.StringField = OneOfTwo_Default_StringField
+		paths = append(paths, "string_field")
+	}
+	return paths
+}
+
+func (x *OneOfTwo) StripDefaults() {
+	if x.StringField == "string_field" {
+		x.StringField = ""
+	}
+}
+
+func (x *OneOfTwo) IsDefault() bool {
+	if x.StringField != "" && x.StringField != "string_field" {
+		return false
+	}
+	return true
+}
+
+func NewOneOfTwo() *OneOfTwo {
+	x := &OneOfTwo{}
+	x.Default()
+	return x
+}
+
+func NewOneOfTwoWith(fn func(x *OneOfTwo)) *OneOfTwo {
+	x := &OneOfTwo{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *OneOfThree) Default() {
+}
+
+func (x *OneOfThree) DefaultE() error {
+	var errs defaults.Errors
+	return errs.Err()
+}
+
+func (x *OneOfThree) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	return paths
+}
+
+func (x *OneOfThree) StripDefaults() {
+}
+
+func (x *OneOfThree) IsDefault() bool {
+	return true
+}
+
+func NewOneOfThree() *OneOfThree {
+	x := &OneOfThree{}
+	x.Default()
+	return x
+}
+
+func NewOneOfThreeWith(fn func(x *OneOfThree)) *OneOfThree {
+	x := &OneOfThree{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Repeated) Default() {
+	if len(x.Strings) == 0 {
+		x.Strings = []string{"one", "two"}
+	}
+	if len(x.Numbers) == 0 {
+		x.Numbers = []int64{1, 2}
+	}
+	if len(x.Fixed64S) == 0 {
+		x.Fixed64S = []uint64{42}
+	}
+	if len(x.Bools) == 0 {
+		x.Bools = []bool{true, false}
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = [][]byte{[]byte("42")}
+	}
+	if len(x.Enums) == 0 {
+		x.Enums = []Types_Enum{Types_Enum(1), Types_TWO, Types_NEGATIVE}
+	}
+	if len(x.StringValues) == 0 {
+		x.StringValues = []*wrapperspb.StringValue{&wrapperspb.StringValue{Value: "42"}}
+	}
+	if len(x.Durations) == 0 {
+		x.Durations = []*durationpb.Duration{durationpb.New(3600000000000), durationpb.New(172800000000000)}
+	}
+	if len(x.Timestamps) == 0 {
+		x.Timestamps = []*timestamppb.Timestamp{&timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}}
+	}
+	if len(x.Messages) == 0 {
+		x.Messages = []*Message{&Message{}, &Message{}}
+		if v, ok := interface{}(x.Messages[0]).(interface{ Default() }); ok && x.Messages[0] != nil {
+			v.Default()
+		}
+	}
+}
+
+func (x *Repeated) DefaultE() error {
+	var errs defaults.Errors
+	if len(x.Strings) == 0 {
+		x.Strings = []string{"one", "two"}
+	}
+	if len(x.Numbers) == 0 {
+		x.Numbers = []int64{1, 2}
+	}
+	if len(x.Fixed64S) == 0 {
+		x.Fixed64S = []uint64{42}
+	}
+	if len(x.Bools) == 0 {
+		x.Bools = []bool{true, false}
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = [][]byte{[]byte("42")}
+	}
+	if len(x.Enums) == 0 {
+		x.Enums = []Types_Enum{Types_Enum(1), Types_TWO, Types_NEGATIVE}
+	}
+	if len(x.StringValues) == 0 {
+		x.StringValues = []*wrapperspb.StringValue{&wrapperspb.StringValue{Value: "42"}}
+	}
+	if len(x.Durations) == 0 {
+		x.Durations = []*durationpb.Duration{durationpb.New(3600000000000), durationpb.New(172800000000000)}
+	}
+	if len(x.Timestamps) == 0 {
+		x.Timestamps = []*timestamppb.Timestamp{&timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}}
+	}
+	if len(x.Messages) == 0 {
+		x.Messages = []*Message{&Message{}, &Message{}}
+		if v, ok := interface{}(x.Messages[0]).(interface{ DefaultE() error }); ok && x.Messages[0] != nil {
+			errs.AddKey("messages", 0, v.DefaultE())
+		} else if v, ok := interface{}(x.Messages[0]).(interface{ Default() }); ok && x.Messages[0] != nil {
+			v.Default()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Repeated) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if len(x.Strings) == 0 {
+		x.Strings = []string{"one", "two"}
+		paths = append(paths, "strings")
+	}
+	if len(x.Numbers) == 0 {
+		x.Numbers = []int64{1, 2}
+		paths = append(paths, "numbers")
+	}
+	if len(x.Fixed64S) == 0 {
+		x.Fixed64S = []uint64{42}
+		paths = append(paths, "fixed64s")
+	}
+	if len(x.Bools) == 0 {
+		x.Bools = []bool{true, false}
+		paths = append(paths, "bools")
+	}
+	if len(x.Bytes) == 0 {
+		x.Bytes = [][]byte{[]byte("42")}
+		paths = append(paths, "bytes")
+	}
+	if len(x.Enums) == 0 {
+		x.Enums = []Types_Enum{Types_Enum(1), Types_TWO, Types_NEGATIVE}
+		paths = append(paths, "enums")
+	}
+	if len(x.StringValues) == 0 {
+		x.StringValues = []*wrapperspb.StringValue{&wrapperspb.StringValue{Value: "42"}}
+		paths = append(paths, "string_values")
+	}
+	if len(x.Durations) == 0 {
+		x.Durations = []*durationpb.Duration{durationpb.New(3600000000000), durationpb.New(172800000000000)}
+		paths = append(paths, "durations")
+	}
+	if len(x.Timestamps) == 0 {
+		x.Timestamps = []*timestamppb.Timestamp{&timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}}
+		paths = append(paths, "timestamps")
+	}
+	if len(x.Messages) == 0 {
+		x.Messages = []*Message{&Message{}, &Message{}}
+		paths = append(paths, "messages")
+		if v, ok := interface{}(x.Messages[0]).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Messages[0] != nil {
+			paths = append(paths, defaults.PrefixPaths(defaults.KeyPath("messages", 0), v.DefaultReport())...)
+		} else if v, ok := interface{}(x.Messages[0]).(interface{ Default() }); ok && x.Messages[0] != nil {
+			v.Default()
+		}
+	}
+	return paths
+}
+
+func (x *Repeated) StripDefaults() {
+	if len(x.Messages) == 2 && proto.Equal(x.Messages[0], func() *Message {
+		d := &Message{}
+		if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+			v.Default()
+		}
+		return d
+	}()) && proto.Equal(x.Messages[1], &Message{}) {
+		x.Messages = nil
+	}
+	if len(x.Timestamps) == 1 && proto.Equal(x.Timestamps[0], &timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}) {
+		x.Timestamps = nil
+	}
+	if len(x.Durations) == 2 && proto.Equal(x.Durations[0], durationpb.New(3600000000000)) && proto.Equal(x.Durations[1], durationpb.New(172800000000000)) {
+		x.Durations = nil
+	}
+	if len(x.StringValues) == 1 && proto.Equal(x.StringValues[0], &wrapperspb.StringValue{Value: "42"}) {
+		x.StringValues = nil
+	}
+	if len(x.Enums) == 3 && x.Enums[0] == Types_Enum(1) && x.Enums[1] == Types_TWO && x.Enums[2] == Types_NEGATIVE {
+		x.Enums = nil
+	}
+	if len(x.Bytes) == 1 && string(x.Bytes[0]) == "42" {
+		x.Bytes = nil
+	}
+	if len(x.Bools) == 2 && x.Bools[0] == true && x.Bools[1] == false {
+		x.Bools = nil
+	}
+	if len(x.Fixed64S) == 1 && x.Fixed64S[0] == 42 {
+		x.Fixed64S = nil
+	}
+	if len(x.Numbers) == 2 && x.Numbers[0] == 1 && x.Numbers[1] == 2 {
+		x.Numbers = nil
+	}
+	if len(x.Strings) == 2 && x.Strings[0] == "one" && x.Strings[1] == "two" {
+		x.Strings = nil
+	}
+}
+
+func (x *Repeated) IsDefault() bool {
+	if len(x.Strings) != 0 && (len(x.Strings) != 2 || !(x.Strings[0] == "one") || !(x.Strings[1] == "two")) {
+		return false
+	}
+	if len(x.Numbers) != 0 && (len(x.Numbers) != 2 || !(x.Numbers[0] == 1) || !(x.Numbers[1] == 2)) {
+		return false
+	}
+	if len(x.Fixed64S) != 0 && (len(x.Fixed64S) != 1 || !(x.Fixed64S[0] == 42)) {
+		return false
+	}
+	if len(x.Bools) != 0 && (len(x.Bools) != 2 || !(x.Bools[0] == true) || !(x.Bools[1] == false)) {
+		return false
+	}
+	if len(x.Bytes) != 0 && (len(x.Bytes) != 1 || !(string(x.Bytes[0]) == "42")) {
+		return false
+	}
+	if len(x.Enums) != 0 && (len(x.Enums) != 3 || !(x.Enums[0] == Types_Enum(1)) || !(x.Enums[1] == Types_TWO) || !(x.Enums[2] == Types_NEGATIVE)) {
+		return false
+	}
+	if len(x.StringValues) != 0 && (len(x.StringValues) != 1 || !(proto.Equal(x.StringValues[0], &wrapperspb.StringValue{Value: "42"}))) {
+		return false
+	}
+	if len(x.Durations) != 0 && (len(x.Durations) != 2 || !(proto.Equal(x.Durations[0], durationpb.New(3600000000000))) || !(proto.Equal(x.Durations[1], durationpb.New(172800000000000)))) {
+		return false
+	}
+	if len(x.Timestamps) != 0 && (len(x.Timestamps) != 1 || !(proto.Equal(x.Timestamps[0], &timestamppb.Timestamp{Seconds: -562032000, Nanos: 0}))) {
+		return false
+	}
+	if len(x.Messages) != 0 && (len(x.Messages) != 2 || !(proto.Equal(x.Messages[0], func() *Message {
+		d := &Message{}
+		if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+			v.Default()
+		}
+		return d
+	}())) || !(proto.Equal(x.Messages[1], &Message{}))) {
+		return false
+	}
+	return true
+}
+
+func NewRepeated() *Repeated {
+	x := &Repeated{}
+	x.Default()
+	return x
+}
+
+func NewRepeatedWith(fn func(x *Repeated)) *Repeated {
+	x := &Repeated{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Maps) Default() {
+	if len(x.Labels) == 0 {
+		x.Labels = make(map[string]string)
+		x.Labels["app"] = "defaults"
+	}
+	if x.Merged == nil {
+		x.Merged = make(map[string]string)
+	}
+	if _, ok := x.Merged["one"]; !ok {
+		x.Merged["one"] = "1"
+	}
+	if _, ok := x.Merged["two"]; !ok {
+		x.Merged["two"] = "2"
+	}
+	if len(x.Enums) == 0 {
+		x.Enums = make(map[int32]Types_Enum)
+		x.Enums[1] = Types_Enum(1)
+	}
+	if len(x.Durations) == 0 {
+		x.Durations = make(map[bool]*durationpb.Duration)
+		x.Durations[true] = durationpb.New(30000000000)
+	}
+	if len(x.Messages) == 0 {
+		x.Messages = make(map[string]*Message)
+		x.Messages["default"] = &Message{}
+	}
+	for k, v := range x.Messages {
+		if v == nil {
+			v = &Message{}
+			x.Messages[k] = v
+		}
+		if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+}
+
+func (x *Maps) DefaultE() error {
+	var errs defaults.Errors
+	if len(x.Labels) == 0 {
+		x.Labels = make(map[string]string)
+		x.Labels["app"] = "defaults"
+	}
+	if x.Merged == nil {
+		x.Merged = make(map[string]string)
+	}
+	if _, ok := x.Merged["one"]; !ok {
+		x.Merged["one"] = "1"
+	}
+	if _, ok := x.Merged["two"]; !ok {
+		x.Merged["two"] = "2"
+	}
+	if len(x.Enums) == 0 {
+		x.Enums = make(map[int32]Types_Enum)
+		x.Enums[1] = Types_Enum(1)
+	}
+	if len(x.Durations) == 0 {
+		x.Durations = make(map[bool]*durationpb.Duration)
+		x.Durations[true] = durationpb.New(30000000000)
+	}
+	if len(x.Messages) == 0 {
+		x.Messages = make(map[string]*Message)
+		x.Messages["default"] = &Message{}
+	}
+	for k, v := range x.Messages {
+		if v == nil {
+			v = &Message{}
+			x.Messages[k] = v
+		}
+		if v, ok := interface{}(v).(interface{ DefaultE() error }); ok && v != nil {
+			errs.AddKey("messages", k, v.DefaultE())
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Maps) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if len(x.Labels) == 0 {
+		x.Labels = make(map[string]string)
+		x.Labels["app"] = "defaults"
+		paths = append(paths, defaults.KeyPath("labels", "app"))
+	}
+	if x.Merged == nil {
+		x.Merged = make(map[string]string)
+	}
+	if _, ok := x.Merged["one"]; !ok {
+		x.Merged["one"] = "1"
+		paths = append(paths, defaults.KeyPath("merged", "one"))
+	}
+	if _, ok := x.Merged["two"]; !ok {
+		x.Merged["two"] = "2"
+		paths = append(paths, defaults.KeyPath("merged", "two"))
+	}
+	if len(x.Enums) == 0 {
+		x.Enums = make(map[int32]Types_Enum)
+		x.Enums[1] = Types_Enum(1)
+		paths = append(paths, defaults.KeyPath("enums", 1))
+	}
+	if len(x.Durations) == 0 {
+		x.Durations = make(map[bool]*durationpb.Duration)
+		x.Durations[true] = durationpb.New(30000000000)
+		paths = append(paths, defaults.KeyPath("durations", true))
+	}
+	if len(x.Messages) == 0 {
+		x.Messages = make(map[string]*Message)
+		x.Messages["default"] = &Message{}
+		paths = append(paths, defaults.KeyPath("messages", "default"))
+	}
+	for k, v := range x.Messages {
+		if v == nil {
+			v = &Message{}
+			x.Messages[k] = v
+			paths = append(paths, defaults.KeyPath("messages", k))
+		}
+		if v, ok := interface{}(v).(interface{ DefaultReport() []defaults.FieldPath }); ok && v != nil {
+			paths = append(paths, defaults.PrefixPaths(defaults.KeyPath("messages", k), v.DefaultReport())...)
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	return paths
+}
+
+func (x *Maps) StripDefaults() {
+	if len(x.Messages) == 1 {
+		def := true
+		if v, ok := x.Messages["default"]; !ok || !(proto.Equal(v, func() *Message {
+			d := &Message{}
+			if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+				v.Default()
+			}
+			return d
+		}())) {
+			def = false
+		}
+		if def {
+			x.Messages = nil
+		}
+	}
+	for _, v := range x.Messages {
+		if s, ok := interface{}(v).(interface{ StripDefaults() }); ok && v != nil {
+			s.StripDefaults()
+		}
+	}
+	if len(x.Durations) == 1 {
+		def := true
+		if v, ok := x.Durations[true]; !ok || !(proto.Equal(v, durationpb.New(30000000000))) {
+			def = false
+		}
+		if def {
+			x.Durations = nil
+		}
+	}
+	if len(x.Enums) == 1 {
+		def := true
+		if v, ok := x.Enums[1]; !ok || !(v == Types_Enum(1)) {
+			def = false
+		}
+		if def {
+			x.Enums = nil
+		}
+	}
+	if v, ok := x.Merged["one"]; ok && v == "1" {
+		delete(x.Merged, "one")
+	}
+	if v, ok := x.Merged["two"]; ok && v == "2" {
+		delete(x.Merged, "two")
+	}
+	if x.Merged != nil && len(x.Merged) == 0 {
+		x.Merged = nil
+	}
+	if len(x.Labels) == 1 {
+		def := true
+		if v, ok := x.Labels["app"]; !ok || !(v == "defaults") {
+			def = false
+		}
+		if def {
+			x.Labels = nil
+		}
+	}
+}
+
+func (x *Maps) IsDefault() bool {
+	if len(x.Labels) != 0 {
+		if len(x.Labels) != 1 {
+			return false
+		}
+		if v, ok := x.Labels["app"]; !ok || !(v == "defaults") {
+			return false
+		}
+	}
+	if len(x.Merged) != 0 {
+		if len(x.Merged) != 2 {
+			return false
+		}
+		if v, ok := x.Merged["one"]; !ok || !(v == "1") {
+			return false
+		}
+		if v, ok := x.Merged["two"]; !ok || !(v == "2") {
+			return false
+		}
+	}
+	if len(x.Enums) != 0 {
+		if len(x.Enums) != 1 {
+			return false
+		}
+		if v, ok := x.Enums[1]; !ok || !(v == Types_Enum(1)) {
+			return false
+		}
+	}
+	if len(x.Durations) != 0 {
+		if len(x.Durations) != 1 {
+			return false
+		}
+		if v, ok := x.Durations[true]; !ok || !(proto.Equal(v, durationpb.New(30000000000))) {
+			return false
+		}
+	}
+	if len(x.Messages) != 0 {
+		if len(x.Messages) != 1 {
+			return false
+		}
+		if v, ok := x.Messages["default"]; !ok || !(proto.Equal(v, func() *Message {
+			d := &Message{}
+			if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+				v.Default()
+			}
+			return d
+		}())) {
+			return false
+		}
+	}
+	for _, v := range x.Messages {
+		if d, ok := interface{}(v).(interface{ IsDefault() bool }); ok && v != nil && !d.IsDefault() {
+			return false
+		}
+	}
+	return true
+}
+
+func NewMaps() *Maps {
+	x := &Maps{}
+	x.Default()
+	return x
+}
+
+func NewMapsWith(fn func(x *Maps)) *Maps {
+	x := &Maps{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Elements) Default() {
+	for _, v := range x.Messages {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	for k, v := range x.Initialized {
+		if v == nil {
+			v = &Message{}
+			x.Initialized[k] = v
+		}
+		if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	for _, v := range x.Values {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+}
+
+func (x *Elements) DefaultE() error {
+	var errs defaults.Errors
+	for k, v := range x.Messages {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ DefaultE() error }); ok && v != nil {
+			errs.AddKey("messages", k, v.DefaultE())
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	for k, v := range x.Initialized {
+		if v == nil {
+			v = &Message{}
+			x.Initialized[k] = v
+		}
+		if v, ok := interface{}(v).(interface{ DefaultE() error }); ok && v != nil {
+			errs.AddKey("initialized", k, v.DefaultE())
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	for k, v := range x.Values {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ DefaultE() error }); ok && v != nil {
+			errs.AddKey("values", k, v.DefaultE())
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Elements) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	for k, v := range x.Messages {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ DefaultReport() []defaults.FieldPath }); ok && v != nil {
+			paths = append(paths, defaults.PrefixPaths(defaults.KeyPath("messages", k), v.DefaultReport())...)
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	for k, v := range x.Initialized {
+		if v == nil {
+			v = &Message{}
+			x.Initialized[k] = v
+			paths = append(paths, defaults.KeyPath("initialized", k))
+		}
+		if v, ok := interface{}(v).(interface{ DefaultReport() []defaults.FieldPath }); ok && v != nil {
+			paths = append(paths, defaults.PrefixPaths(defaults.KeyPath("initialized", k), v.DefaultReport())...)
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	for k, v := range x.Values {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ DefaultReport() []defaults.FieldPath }); ok && v != nil {
+			paths = append(paths, defaults.PrefixPaths(defaults.KeyPath("values", k), v.DefaultReport())...)
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	return paths
+}
+
+func (x *Elements) StripDefaults() {
+	for _, v := range x.Values {
+		if s, ok := interface{}(v).(interface{ StripDefaults() }); ok && v != nil {
+			s.StripDefaults()
+		}
+	}
+	for _, v := range x.Initialized {
+		if s, ok := interface{}(v).(interface{ StripDefaults() }); ok && v != nil {
+			s.StripDefaults()
+		}
+	}
+	for _, v := range x.Messages {
+		if s, ok := interface{}(v).(interface{ StripDefaults() }); ok && v != nil {
+			s.StripDefaults()
+		}
+	}
+}
+
+func (x *Elements) IsDefault() bool {
+	for _, v := range x.Messages {
+		if d, ok := interface{}(v).(interface{ IsDefault() bool }); ok && v != nil && !d.IsDefault() {
+			return false
+		}
+	}
+	for _, v := range x.Initialized {
+		if d, ok := interface{}(v).(interface{ IsDefault() bool }); ok && v != nil && !d.IsDefault() {
+			return false
+		}
+	}
+	for _, v := range x.Values {
+		if d, ok := interface{}(v).(interface{ IsDefault() bool }); ok && v != nil && !d.IsDefault() {
+			return false
+		}
+	}
+	return true
+}
+
+func NewElements() *Elements {
+	x := &Elements{}
+	x.Default()
+	return x
+}
+
+func NewElementsWith(fn func(x *Elements)) *Elements {
+	x := &Elements{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Literal) Default() {
+	if x.Text == nil {
+		x.Text = &Literal_Policy{MaxAttempts: 3, Backoff: &durationpb.Duration{Seconds: 1}, Codes: []string{"UNAVAILABLE"}, Weights: map[string]int32{"a": 1}, Enum: Types_TWO, Name: func(v string) *string { return &v }("retry"), Kind: &Literal_Policy_Label{Label: "text"}, Nested: &Message{Field: "nested"}, Data: []byte("raw"), Ratio: 0.5}
+	} else {
+		if x.Text.MaxAttempts == 0 {
+			x.Text.MaxAttempts = 3
+		}
+		if x.Text.Backoff == nil {
+			x.Text.Backoff = &durationpb.Duration{Seconds: 1}
+		} else {
+			if x.Text.Backoff.Seconds == 0 {
+				x.Text.Backoff.Seconds = 1
+			}
+		}
+		if len(x.Text.Codes) == 0 {
+			x.Text.Codes = []string{"UNAVAILABLE"}
+		}
+		if len(x.Text.Weights) == 0 {
+			x.Text.Weights = map[string]int32{"a": 1}
+		}
+		if x.Text.Enum == 0 {
+			x.Text.Enum = Types_TWO
+		}
+		if x.Text.Name == nil {
+			x.Text.Name = func(v string) *string { return &v }("retry")
+		}
+		if x.Text.Kind == nil {
+			x.Text.Kind = &Literal_Policy_Label{Label: "text"}
+		}
+		if x.Text.Nested == nil {
+			x.Text.Nested = &Message{Field: "nested"}
+		} else {
+			if x.Text.Nested.Field == "" {
+				x.Text.Nested.Field = "nested"
+			}
+		}
+		if len(x.Text.Data) == 0 {
+			x.Text.Data = []byte("raw")
+		}
+		if x.Text.Ratio == 0 {
+			x.Text.Ratio = 0.5
+		}
+	}
+	if v, ok := interface{}(x.Text).(interface{ Default() }); ok && x.Text != nil {
+		v.Default()
+	}
+	if x.Json == nil {
+		x.Json = &Literal_Policy{MaxAttempts: 5, Backoff: &durationpb.Duration{Seconds: 2}}
+	} else {
+		if x.Json.MaxAttempts == 0 {
+			x.Json.MaxAttempts = 5
+		}
+		if x.Json.Backoff == nil {
+			x.Json.Backoff = &durationpb.Duration{Seconds: 2}
+		} else {
+			if x.Json.Backoff.Seconds == 0 {
+				x.Json.Backoff.Seconds = 2
+			}
+		}
+	}
+	// Json: defaults disabled by [(defaults.value).message = {defaults: false}]
+	if len(x.Items) == 0 {
+		x.Items = []*Literal_Policy{&Literal_Policy{MaxAttempts: 1}}
+	}
+	for k, v := range x.Values {
+		if v == nil {
+			v = &Literal_Policy{MaxAttempts: 2}
+		} else {
+			if v.MaxAttempts == 0 {
+				v.MaxAttempts = 2
+			}
+		}
+		x.Values[k] = v
+	}
+}
+
+func (x *Literal) DefaultE() error {
+	var errs defaults.Errors
+	if x.Text == nil {
+		x.Text = &Literal_Policy{MaxAttempts: 3, Backoff: &durationpb.Duration{Seconds: 1}, Codes: []string{"UNAVAILABLE"}, Weights: map[string]int32{"a": 1}, Enum: Types_TWO, Name: func(v string) *string { return &v }("retry"), Kind: &Literal_Policy_Label{Label: "text"}, Nested: &Message{Field: "nested"}, Data: []byte("raw"), Ratio: 0.5}
+	} else {
+		if x.Text.MaxAttempts == 0 {
+			x.Text.MaxAttempts = 3
+		}
+		if x.Text.Backoff == nil {
+			x.Text.Backoff = &durationpb.Duration{Seconds: 1}
+		} else {
+			if x.Text.Backoff.Seconds == 0 {
+				x.Text.Backoff.Seconds = 1
+			}
+		}
+		if len(x.Text.Codes) == 0 {
+			x.Text.Codes = []string{"UNAVAILABLE"}
+		}
+		if len(x.Text.Weights) == 0 {
+			x.Text.Weights = map[string]int32{"a": 1}
+		}
+		if x.Text.Enum == 0 {
+			x.Text.Enum = Types_TWO
+		}
+		if x.Text.Name == nil {
+			x.Text.Name = func(v string) *string { return &v }("retry")
+		}
+		if x.Text.Kind == nil {
+			x.Text.Kind = &Literal_Policy_Label{Label: "text"}
+		}
+		if x.Text.Nested == nil {
+			x.Text.Nested = &Message{Field: "nested"}
+		} else {
+			if x.Text.Nested.Field == "" {
+				x.Text.Nested.Field = "nested"
+			}
+		}
+		if len(x.Text.Data) == 0 {
+			x.Text.Data = []byte("raw")
+		}
+		if x.Text.Ratio == 0 {
+			x.Text.Ratio = 0.5
+		}
+	}
+	if v, ok := interface{}(x.Text).(interface{ DefaultE() error }); ok && x.Text != nil {
+		errs.Add("text", v.DefaultE())
+	} else if v, ok := interface{}(x.Text).(interface{ Default() }); ok && x.Text != nil {
+		v.Default()
+	}
+	if x.Json == nil {
+		x.Json = &Literal_Policy{MaxAttempts: 5, Backoff: &durationpb.Duration{Seconds: 2}}
+	} else {
+		if x.Json.MaxAttempts == 0 {
+			x.Json.MaxAttempts = 5
+		}
+		if x.Json.Backoff == nil {
+			x.Json.Backoff = &durationpb.Duration{Seconds: 2}
+		} else {
+			if x.Json.Backoff.Seconds == 0 {
+				x.Json.Backoff.Seconds = 2
+			}
+		}
+	}
+	// Json: defaults disabled by [(defaults.value).message = {defaults: false}]
+	if len(x.Items) == 0 {
+		x.Items = []*Literal_Policy{&Literal_Policy{MaxAttempts: 1}}
+	}
+	for k, v := range x.Values {
+		if v == nil {
+			v = &Literal_Policy{MaxAttempts: 2}
+		} else {
+			if v.MaxAttempts == 0 {
+				v.MaxAttempts = 2
+			}
+		}
+		x.Values[k] = v
+	}
+	return errs.Err()
+}
+
+func (x *Literal) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Text == nil {
+		x.Text = &Literal_Policy{MaxAttempts: 3, Backoff: &durationpb.Duration{Seconds: 1}, Codes: []string{"UNAVAILABLE"}, Weights: map[string]int32{"a": 1}, Enum: Types_TWO, Name: func(v string) *string { return &v }("retry"), Kind: &Literal_Policy_Label{Label: "text"}, Nested: &Message{Field: "nested"}, Data: []byte("raw"), Ratio: 0.5}
+		paths = append(paths, "text")
+	} else {
+		if x.Text.MaxAttempts == 0 {
+			x.Text.MaxAttempts = 3
+			paths = append(paths, "text.max_attempts")
+		}
+		if x.Text.Backoff == nil {
+			x.Text.Backoff = &durationpb.Duration{Seconds: 1}
+			paths = append(paths, "text.backoff")
+		} else {
+			if x.Text.Backoff.Seconds == 0 {
+				x.Text.Backoff.Seconds = 1
+				paths = append(paths, "text.backoff.seconds")
+			}
+		}
+		if len(x.Text.Codes) == 0 {
+			x.Text.Codes = []string{"UNAVAILABLE"}
+			paths = append(paths, "text.codes")
+		}
+		if len(x.Text.Weights) == 0 {
+			x.Text.Weights = map[string]int32{"a": 1}
+			paths = append(paths, "text.weights")
+		}
+		if x.Text.Enum == 0 {
+			x.Text.Enum = Types_TWO
+			paths = append(paths, "text.enum")
+		}
+		if x.Text.Name == nil {
+			x.Text.Name = func(v string) *string { return &v }("retry")
+			paths = append(paths, "text.name")
+		}
+		if x.Text.Kind == nil {
+			x.Text.Kind = &Literal_Policy_Label{Label: "text"}
+			paths = append(paths, "text.label")
+		}
+		if x.Text.Nested == nil {
+			x.Text.Nested = &Message{Field: "nested"}
+			paths = append(paths, "text.nested")
+		} else {
+			if x.Text.Nested.Field == "" {
+				x.Text.Nested.Field = "nested"
+				paths = append(paths, "text.nested.field")
+			}
+		}
+		if len(x.Text.Data) == 0 {
+			x.Text.Data = []byte("raw")
+			paths = append(paths, "text.data")
+		}
+		if x.Text.Ratio == 0 {
+			x.Text.Ratio = 0.5
+			paths = append(paths, "text.ratio")
+		}
+	}
+	if v, ok := interface{}(x.Text).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Text != nil {
+		paths = append(paths, defaults.PrefixPaths("text", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Text).(interface{ Default() }); ok && x.Text != nil {
+		v.Default()
+	}
+	if x.Json == nil {
+		x.Json = &Literal_Policy{MaxAttempts: 5, Backoff: &durationpb.Duration{Seconds: 2}}
+		paths = append(paths, "json")
+	} else {
+		if x.Json.MaxAttempts == 0 {
+			x.Json.MaxAttempts = 5
+			paths = append(paths, "json.max_attempts")
+		}
+		if x.Json.Backoff == nil {
+			x.Json.Backoff = &durationpb.Duration{Seconds: 2}
+			paths = append(paths, "json.backoff")
+		} else {
+			if x.Json.Backoff.Seconds == 0 {
+				x.Json.Backoff.Seconds = 2
+				paths = append(paths, "json.backoff.seconds")
+			}
+		}
+	}
+	// Json: defaults disabled by [(defaults.value).message = {defaults: false}]
+	if len(x.Items) == 0 {
+		x.Items = []*Literal_Policy{&Literal_Policy{MaxAttempts: 1}}
+		paths = append(paths, "items")
+	}
+	for k, v := range x.Values {
+		if v == nil {
+			v = &Literal_Policy{MaxAttempts: 2}
+			paths = append(paths, defaults.KeyPath("values", k))
+		} else {
+			if v.MaxAttempts == 0 {
+				v.MaxAttempts = 2
+				paths = append(paths, defaults.KeyPath("values", k)+".max_attempts")
+			}
+		}
+		x.Values[k] = v
+	}
+	return paths
+}
+
+func (x *Literal) StripDefaults() {
+	if len(x.Items) == 1 && proto.Equal(x.Items[0], &Literal_Policy{MaxAttempts: 1}) {
+		x.Items = nil
+	}
+	if x.Json != nil && proto.Equal(x.Json, &Literal_Policy{MaxAttempts: 5, Backoff: &durationpb.Duration{Seconds: 2}}) {
+		x.Json = nil
+	}
+	if x.Text != nil && proto.Equal(x.Text, func() *Literal_Policy {
+		d := &Literal_Policy{MaxAttempts: 3, Backoff: &durationpb.Duration{Seconds: 1}, Codes: []string{"UNAVAILABLE"}, Weights: map[string]int32{"a": 1}, Enum: Types_TWO, Name: func(v string) *string { return &v }("retry"), Kind: &Literal_Policy_Label{Label: "text"}, Nested: &Message{Field: "nested"}, Data: []byte("raw"), Ratio: 0.5}
+		if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+			v.Default()
+		}
+		return d
+	}()) {
+		x.Text = nil
+	}
+}
+
+func (x *Literal) IsDefault() bool {
+	if x.Text != nil && !proto.Equal(x.Text, func() *Literal_Policy {
+		d := &Literal_Policy{MaxAttempts: 3, Backoff: &durationpb.Duration{Seconds: 1}, Codes: []string{"UNAVAILABLE"}, Weights: map[string]int32{"a": 1}, Enum: Types_TWO, Name: func(v string) *string { return &v }("retry"), Kind: &Literal_Policy_Label{Label: "text"}, Nested: &Message{Field: "nested"}, Data: []byte("raw"), Ratio: 0.5}
+		if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+			v.Default()
+		}
+		return d
+	}()) {
+		return false
+	}
+	if x.Json != nil && !proto.Equal(x.Json, &Literal_Policy{MaxAttempts: 5, Backoff: &durationpb.Duration{Seconds: 2}}) {
+		return false
+	}
+	if len(x.Items) != 0 && (len(x.Items) != 1 || !(proto.Equal(x.Items[0], &Literal_Policy{MaxAttempts: 1}))) {
+		return false
+	}
+	return true
+}
+
+func NewLiteral() *Literal {
+	x := &Literal{}
+	x.Default()
+	return x
+}
+
+func NewLiteralWith(fn func(x *Literal)) *Literal {
+	x := &Literal{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *LiteralExtension) Default() {
+	if x.Options == nil {
+		x.Options = &descriptorpb.FieldOptions{Deprecated: func(v bool) *bool { return &v }(true)}
+	} else {
+		if x.Options.Deprecated == nil {
+			x.Options.Deprecated = func(v bool) *bool { return &v }(true)
+		}
+	}
+	if v, ok := interface{}(x.Options).(interface{ Default() }); ok && x.Options != nil {
+		v.Default()
+	}
+}
+
+func (x *LiteralExtension) DefaultE() error {
+	var errs defaults.Errors
+	if x.Options == nil {
+		x.Options = &descriptorpb.FieldOptions{Deprecated: func(v bool) *bool { return &v }(true)}
+	} else {
+		if x.Options.Deprecated == nil {
+			x.Options.Deprecated = func(v bool) *bool { return &v }(true)
+		}
+	}
+	if v, ok := interface{}(x.Options).(interface{ DefaultE() error }); ok && x.Options != nil {
+		errs.Add("options", v.DefaultE())
+	} else if v, ok := interface{}(x.Options).(interface{ Default() }); ok && x.Options != nil {
+		v.Default()
+	}
+	return errs.Err()
+}
+
+func (x *LiteralExtension) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Options == nil {
+		x.Options = &descriptorpb.FieldOptions{Deprecated: func(v bool) *bool { return &v }(true)}
+		paths = append(paths, "options")
+	} else {
+		if x.Options.Deprecated == nil {
+			x.Options.Deprecated = func(v bool) *bool { return &v }(true)
+			paths = append(paths, "options.deprecated")
+		}
+	}
+	if v, ok := interface{}(x.Options).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Options != nil {
+		paths = append(paths, defaults.PrefixPaths("options", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Options).(interface{ Default() }); ok && x.Options != nil {
+		v.Default()
+	}
+	return paths
+}
+
+func (x *LiteralExtension) StripDefaults() {
+	if x.Options != nil && proto.Equal(x.Options, func() *descriptorpb.FieldOptions {
+		d := &descriptorpb.FieldOptions{Deprecated: func(v bool) *bool { return &v }(true)}
+		if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+			v.Default()
+		}
+		return d
+	}()) {
+		x.Options = nil
+	}
+}
+
+func (x *LiteralExtension) IsDefault() bool {
+	if x.Options != nil && !proto.Equal(x.Options, func() *descriptorpb.FieldOptions {
+		d := &descriptorpb.FieldOptions{Deprecated: func(v bool) *bool { return &v }(true)}
+		if v, ok := interface{}(d).(interface{ Default() }); ok && d != nil {
+			v.Default()
+		}
+		return d
+	}()) {
+		return false
+	}
+	return true
+}
+
+func NewLiteralExtension() *LiteralExtension {
+	x := &LiteralExtension{}
+	x.Default()
+	return x
+}
+
+func NewLiteralExtensionWith(fn func(x *LiteralExtension)) *LiteralExtension {
+	x := &LiteralExtension{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Generated) Default() {
+	if x.UuidV4 == "" {
+		if v, err := defaults.Generate(defaults.Generator_UUID_V4, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uuid_v4", Err: err})
+		} else {
+			x.UuidV4 = v
+		}
+	}
+	if x.UuidV7 == "" {
+		if v, err := defaults.Generate(defaults.Generator_UUID_V7, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uuid_v7", Err: err})
+		} else {
+			x.UuidV7 = v
+		}
+	}
+	if x.Ulid == "" {
+		if v, err := defaults.Generate(defaults.Generator_ULID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "ulid", Err: err})
+		} else {
+			x.Ulid = v
+		}
+	}
+	if x.Xid == "" {
+		if v, err := defaults.Generate(defaults.Generator_XID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "xid", Err: err})
+		} else {
+			x.Xid = v
+		}
+	}
+	if x.ObjectId == "" {
+		if v, err := defaults.Generate(defaults.Generator_OBJECT_ID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "object_id", Err: err})
+		} else {
+			x.ObjectId = v
+		}
+	}
+	if x.RandomHex == "" {
+		if v, err := defaults.Generate(defaults.Generator_RANDOM_HEX, 8); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "random_hex", Err: err})
+		} else {
+			x.RandomHex = v
+		}
+	}
+	if x.Hostname == "" {
+		if v, err := defaults.Generate(defaults.Generator_HOSTNAME, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "hostname", Err: err})
+		} else {
+			x.Hostname = v
+		}
+	}
+	if len(x.UuidBytes) == 0 {
+		if v, err := defaults.GenerateBytes(defaults.Generator_UUID_V4, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uuid_bytes", Err: err})
+		} else {
+			x.UuidBytes = v
+		}
+	}
+	if len(x.RandomBytes) == 0 {
+		if v, err := defaults.GenerateBytes(defaults.Generator_RANDOM_HEX, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "random_bytes", Err: err})
+		} else {
+			x.RandomBytes = v
+		}
+	}
+	if x.OptionalId == nil {
+		if v, err := defaults.Generate(defaults.Generator_ULID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "optional_id", Err: err})
+		} else {
+			x.OptionalId = &v
+		}
+	}
+	if x.StringValue == nil {
+		if v, err := defaults.Generate(defaults.Generator_XID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "string_value", Err: err})
+		} else {
+			x.StringValue = &wrapperspb.StringValue{Value: v}
+		}
+	}
+	if x.BytesValue == nil {
+		if v, err := defaults.GenerateBytes(defaults.Generator_OBJECT_ID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "bytes_value", Err: err})
+		} else {
+			x.BytesValue = &wrapperspb.BytesValue{Value: v}
+		}
+	}
+}
+
+func (x *Generated) DefaultE() error {
+	var errs defaults.Errors
+	if x.UuidV4 == "" {
+		if v, err := defaults.Generate(defaults.Generator_UUID_V4, 0); err != nil {
+			errs.Add("uuid_v4", err)
+		} else {
+			x.UuidV4 = v
+		}
+	}
+	if x.UuidV7 == "" {
+		if v, err := defaults.Generate(defaults.Generator_UUID_V7, 0); err != nil {
+			errs.Add("uuid_v7", err)
+		} else {
+			x.UuidV7 = v
+		}
+	}
+	if x.Ulid == "" {
+		if v, err := defaults.Generate(defaults.Generator_ULID, 0); err != nil {
+			errs.Add("ulid", err)
+		} else {
+			x.Ulid = v
+		}
+	}
+	if x.Xid == "" {
+		if v, err := defaults.Generate(defaults.Generator_XID, 0); err != nil {
+			errs.Add("xid", err)
+		} else {
+			x.Xid = v
+		}
+	}
+	if x.ObjectId == "" {
+		if v, err := defaults.Generate(defaults.Generator_OBJECT_ID, 0); err != nil {
+			errs.Add("object_id", err)
+		} else {
+			x.ObjectId = v
+		}
+	}
+	if x.RandomHex == "" {
+		if v, err := defaults.Generate(defaults.Generator_RANDOM_HEX, 8); err != nil {
+			errs.Add("random_hex", err)
+		} else {
+			x.RandomHex = v
+		}
+	}
+	if x.Hostname == "" {
+		if v, err := defaults.Generate(defaults.Generator_HOSTNAME, 0); err != nil {
+			errs.Add("hostname", err)
+		} else {
+			x.Hostname = v
+		}
+	}
+	if len(x.UuidBytes) == 0 {
+		if v, err := defaults.GenerateBytes(defaults.Generator_UUID_V4, 0); err != nil {
+			errs.Add("uuid_bytes", err)
+		} else {
+			x.UuidBytes = v
+		}
+	}
+	if len(x.RandomBytes) == 0 {
+		if v, err := defaults.GenerateBytes(defaults.Generator_RANDOM_HEX, 0); err != nil {
+			errs.Add("random_bytes", err)
+		} else {
+			x.RandomBytes = v
+		}
+	}
+	if x.OptionalId == nil {
+		if v, err := defaults.Generate(defaults.Generator_ULID, 0); err != nil {
+			errs.Add("optional_id", err)
+		} else {
+			x.OptionalId = &v
+		}
+	}
+	if x.StringValue == nil {
+		if v, err := defaults.Generate(defaults.Generator_XID, 0); err != nil {
+			errs.Add("string_value", err)
+		} else {
+			x.StringValue = &wrapperspb.StringValue{Value: v}
+		}
+	}
+	if x.BytesValue == nil {
+		if v, err := defaults.GenerateBytes(defaults.Generator_OBJECT_ID, 0); err != nil {
+			errs.Add("bytes_value", err)
+		} else {
+			x.BytesValue = &wrapperspb.BytesValue{Value: v}
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Generated) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.UuidV4 == "" {
+		if v, err := defaults.Generate(defaults.Generator_UUID_V4, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uuid_v4", Err: err})
+		} else {
+			x.UuidV4 = v
+			paths = append(paths, "uuid_v4")
+		}
+	}
+	if x.UuidV7 == "" {
+		if v, err := defaults.Generate(defaults.Generator_UUID_V7, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uuid_v7", Err: err})
+		} else {
+			x.UuidV7 = v
+			paths = append(paths, "uuid_v7")
+		}
+	}
+	if x.Ulid == "" {
+		if v, err := defaults.Generate(defaults.Generator_ULID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "ulid", Err: err})
+		} else {
+			x.Ulid = v
+			paths = append(paths, "ulid")
+		}
+	}
+	if x.Xid == "" {
+		if v, err := defaults.Generate(defaults.Generator_XID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "xid", Err: err})
+		} else {
+			x.Xid = v
+			paths = append(paths, "xid")
+		}
+	}
+	if x.ObjectId == "" {
+		if v, err := defaults.Generate(defaults.Generator_OBJECT_ID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "object_id", Err: err})
+		} else {
+			x.ObjectId = v
+			paths = append(paths, "object_id")
+		}
+	}
+	if x.RandomHex == "" {
+		if v, err := defaults.Generate(defaults.Generator_RANDOM_HEX, 8); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "random_hex", Err: err})
+		} else {
+			x.RandomHex = v
+			paths = append(paths, "random_hex")
+		}
+	}
+	if x.Hostname == "" {
+		if v, err := defaults.Generate(defaults.Generator_HOSTNAME, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "hostname", Err: err})
+		} else {
+			x.Hostname = v
+			paths = append(paths, "hostname")
+		}
+	}
+	if len(x.UuidBytes) == 0 {
+		if v, err := defaults.GenerateBytes(defaults.Generator_UUID_V4, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uuid_bytes", Err: err})
+		} else {
+			x.UuidBytes = v
+			paths = append(paths, "uuid_bytes")
+		}
+	}
+	if len(x.RandomBytes) == 0 {
+		if v, err := defaults.GenerateBytes(defaults.Generator_RANDOM_HEX, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "random_bytes", Err: err})
+		} else {
+			x.RandomBytes = v
+			paths = append(paths, "random_bytes")
+		}
+	}
+	if x.OptionalId == nil {
+		if v, err := defaults.Generate(defaults.Generator_ULID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "optional_id", Err: err})
+		} else {
+			x.OptionalId = &v
+			paths = append(paths, "optional_id")
+		}
+	}
+	if x.StringValue == nil {
+		if v, err := defaults.Generate(defaults.Generator_XID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "string_value", Err: err})
+		} else {
+			x.StringValue = &wrapperspb.StringValue{Value: v}
+			paths = append(paths, "string_value")
+		}
+	}
+	if x.BytesValue == nil {
+		if v, err := defaults.GenerateBytes(defaults.Generator_OBJECT_ID, 0); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "bytes_value", Err: err})
+		} else {
+			x.BytesValue = &wrapperspb.BytesValue{Value: v}
+			paths = append(paths, "bytes_value")
+		}
+	}
+	return paths
+}
+
+func (x *Generated) StripDefaults() {
+}
+
+func (x *Generated) IsDefault() bool {
+	return true
+}
+
+func NewGenerated() *Generated {
+	x := &Generated{}
+	x.Default()
+	return x
+}
+
+func NewGeneratedWith(fn func(x *Generated)) *Generated {
+	x := &Generated{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Timestamps) Default() {
+	if x.ExpiresAt == nil {
+		if t, err := defaults.RelativeTime("now+30d", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "expires_at", Err: err})
+		} else {
+			x.ExpiresAt = timestamppb.New(t)
+		}
+	}
+	if x.NotBefore == nil {
+		if t, err := defaults.RelativeTime("now - 1h", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "not_before", Err: err})
+		} else {
+			x.NotBefore = timestamppb.New(t)
+		}
+	}
+	if x.Today == nil {
+		if t, err := defaults.RelativeTime("today", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "today", Err: err})
+		} else {
+			x.Today = timestamppb.New(t)
+		}
+	}
+	if x.Tomorrow == nil {
+		if t, err := defaults.RelativeTime("tomorrow 00:00 UTC", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "tomorrow", Err: err})
+		} else {
+			x.Tomorrow = timestamppb.New(t)
+		}
+	}
+	if x.Morning == nil {
+		if t, err := defaults.RelativeTime("today 08:30 Europe/Paris", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "morning", Err: err})
+		} else {
+			x.Morning = timestamppb.New(t)
+		}
+	}
+	if len(x.Windows) == 0 {
+		x.Windows = []*timestamppb.Timestamp{func() *timestamppb.Timestamp {
+			t, err := defaults.RelativeTime("yesterday", time.Now())
+			if err != nil {
+				defaults.ErrorHandler(&defaults.FieldError{Path: "windows", Err: err})
+				return nil
+			}
+			return timestamppb.New(t)
+		}(), func() *timestamppb.Timestamp {
+			t, err := defaults.RelativeTime("today+12h", time.Now())
+			if err != nil {
+				defaults.ErrorHandler(&defaults.FieldError{Path: "windows", Err: err})
+				return nil
+			}
+			return timestamppb.New(t)
+		}()}
+	}
+}
+
+func (x *Timestamps) DefaultE() error {
+	var errs defaults.Errors
+	if x.ExpiresAt == nil {
+		if t, err := defaults.RelativeTime("now+30d", time.Now()); err != nil {
+			errs.Add("expires_at", err)
+		} else {
+			x.ExpiresAt = timestamppb.New(t)
+		}
+	}
+	if x.NotBefore == nil {
+		if t, err := defaults.RelativeTime("now - 1h", time.Now()); err != nil {
+			errs.Add("not_before", err)
+		} else {
+			x.NotBefore = timestamppb.New(t)
+		}
+	}
+	if x.Today == nil {
+		if t, err := defaults.RelativeTime("today", time.Now()); err != nil {
+			errs.Add("today", err)
+		} else {
+			x.Today = timestamppb.New(t)
+		}
+	}
+	if x.Tomorrow == nil {
+		if t, err := defaults.RelativeTime("tomorrow 00:00 UTC", time.Now()); err != nil {
+			errs.Add("tomorrow", err)
+		} else {
+			x.Tomorrow = timestamppb.New(t)
+		}
+	}
+	if x.Morning == nil {
+		if t, err := defaults.RelativeTime("today 08:30 Europe/Paris", time.Now()); err != nil {
+			errs.Add("morning", err)
+		} else {
+			x.Morning = timestamppb.New(t)
+		}
+	}
+	if len(x.Windows) == 0 {
+		x.Windows = []*timestamppb.Timestamp{func() *timestamppb.Timestamp {
+			t, err := defaults.RelativeTime("yesterday", time.Now())
+			if err != nil {
+				errs.Add("windows", err)
+				return nil
+			}
+			return timestamppb.New(t)
+		}(), func() *timestamppb.Timestamp {
+			t, err := defaults.RelativeTime("today+12h", time.Now())
+			if err != nil {
+				errs.Add("windows", err)
+				return nil
+			}
+			return timestamppb.New(t)
+		}()}
+	}
+	return errs.Err()
+}
+
+func (x *Timestamps) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.ExpiresAt == nil {
+		if t, err := defaults.RelativeTime("now+30d", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "expires_at", Err: err})
+		} else {
+			x.ExpiresAt = timestamppb.New(t)
+			paths = append(paths, "expires_at")
+		}
+	}
+	if x.NotBefore == nil {
+		if t, err := defaults.RelativeTime("now - 1h", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "not_before", Err: err})
+		} else {
+			x.NotBefore = timestamppb.New(t)
+			paths = append(paths, "not_before")
+		}
+	}
+	if x.Today == nil {
+		if t, err := defaults.RelativeTime("today", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "today", Err: err})
+		} else {
+			x.Today = timestamppb.New(t)
+			paths = append(paths, "today")
+		}
+	}
+	if x.Tomorrow == nil {
+		if t, err := defaults.RelativeTime("tomorrow 00:00 UTC", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "tomorrow", Err: err})
+		} else {
+			x.Tomorrow = timestamppb.New(t)
+			paths = append(paths, "tomorrow")
+		}
+	}
+	if x.Morning == nil {
+		if t, err := defaults.RelativeTime("today 08:30 Europe/Paris", time.Now()); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "morning", Err: err})
+		} else {
+			x.Morning = timestamppb.New(t)
+			paths = append(paths, "morning")
+		}
+	}
+	if len(x.Windows) == 0 {
+		x.Windows = []*timestamppb.Timestamp{func() *timestamppb.Timestamp {
+			t, err := defaults.RelativeTime("yesterday", time.Now())
+			if err != nil {
+				defaults.ErrorHandler(&defaults.FieldError{Path: "windows", Err: err})
+				return nil
+			}
+			return timestamppb.New(t)
+		}(), func() *timestamppb.Timestamp {
+			t, err := defaults.RelativeTime("today+12h", time.Now())
+			if err != nil {
+				defaults.ErrorHandler(&defaults.FieldError{Path: "windows", Err: err})
+				return nil
+			}
+			return timestamppb.New(t)
+		}()}
+		paths = append(paths, "windows")
+	}
+	return paths
+}
+
+func (x *Timestamps) StripDefaults() {
+}
+
+func (x *Timestamps) IsDefault() bool {
+	return true
+}
+
+func NewTimestamps() *Timestamps {
+	x := &Timestamps{}
+	x.Default()
+	return x
+}
+
+func NewTimestampsWith(fn func(x *Timestamps)) *Timestamps {
+	x := &Timestamps{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Env) Default() {
+	if x.Int32 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(1), "DEFAULTS_TEST_INT32"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "int32", Err: err})
+		} else if ok {
+			x.Int32 = int32(v.Int())
+		}
+	}
+	if x.Uint64 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(2), "DEFAULTS_TEST_UINT64:-42"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uint64", Err: err})
+		} else if ok {
+			x.Uint64 = v.Uint()
+		}
+	}
+	if x.Sfixed64 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(3), "DEFAULTS_TEST_SFIXED64"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "sfixed64", Err: err})
+		} else if ok {
+			x.Sfixed64 = v.Int()
+		}
+	}
+	if x.Float == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(4), "DEFAULTS_TEST_FLOAT:-0.42"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "float", Err: err})
+		} else if ok {
+			x.Float = float32(v.Float())
+		}
+	}
+	if x.Bool == false {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(5), "DEFAULTS_TEST_BOOL"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "bool", Err: err})
+		} else if ok {
+			x.Bool = v.Bool()
+		}
+	}
+	if x.String_ == "" {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(6), "DEFAULTS_TEST_STRING:-fallback"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "string", Err: err})
+		} else if ok {
+			x.String_ = v.String()
+		}
+	}
+	if len(x.Bytes) == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(7), "DEFAULTS_TEST_BYTES"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "bytes", Err: err})
+		} else if ok {
+			x.Bytes = v.Bytes()
+		}
+	}
+	if x.Enum == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(8), "DEFAULTS_TEST_ENUM:-TWO"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "enum", Err: err})
+		} else if ok {
+			x.Enum = Types_Enum(v.Enum())
+		}
+	}
+	if x.Optional == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(9), "DEFAULTS_TEST_OPTIONAL"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "optional", Err: err})
+		} else if ok {
+			x.Optional = func(v string) *string { return &v }(v.String())
+		}
+	}
+	if x.Timeout == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(10), "DEFAULTS_TEST_TIMEOUT:-30s"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "timeout", Err: err})
+		} else if ok {
+			x.Timeout = v.Message().Interface().(*durationpb.Duration)
+		}
+	}
+	if x.NotBefore == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(11), "DEFAULTS_TEST_NOT_BEFORE"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "not_before", Err: err})
+		} else if ok {
+			x.NotBefore = v.Message().Interface().(*timestamppb.Timestamp)
+		}
+	}
+	if x.Int64Value == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(12), "DEFAULTS_TEST_INT64_VALUE"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "int64_value", Err: err})
+		} else if ok {
+			x.Int64Value = v.Message().Interface().(*wrapperspb.Int64Value)
+		}
+	}
+	if x.Invalid == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(13), "DEFAULTS_TEST_INVALID"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "invalid", Err: err})
+		} else if ok {
+			x.Invalid = int32(v.Int())
+		}
+	}
+}
+
+func (x *Env) DefaultE() error {
+	var errs defaults.Errors
+	if x.Int32 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(1), "DEFAULTS_TEST_INT32"); err != nil {
+			errs.Add("int32", err)
+		} else if ok {
+			x.Int32 = int32(v.Int())
+		}
+	}
+	if x.Uint64 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(2), "DEFAULTS_TEST_UINT64:-42"); err != nil {
+			errs.Add("uint64", err)
+		} else if ok {
+			x.Uint64 = v.Uint()
+		}
+	}
+	if x.Sfixed64 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(3), "DEFAULTS_TEST_SFIXED64"); err != nil {
+			errs.Add("sfixed64", err)
+		} else if ok {
+			x.Sfixed64 = v.Int()
+		}
+	}
+	if x.Float == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(4), "DEFAULTS_TEST_FLOAT:-0.42"); err != nil {
+			errs.Add("float", err)
+		} else if ok {
+			x.Float = float32(v.Float())
+		}
+	}
+	if x.Bool == false {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(5), "DEFAULTS_TEST_BOOL"); err != nil {
+			errs.Add("bool", err)
+		} else if ok {
+			x.Bool = v.Bool()
+		}
+	}
+	if x.String_ == "" {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(6), "DEFAULTS_TEST_STRING:-fallback"); err != nil {
+			errs.Add("string", err)
+		} else if ok {
+			x.String_ = v.String()
+		}
+	}
+	if len(x.Bytes) == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(7), "DEFAULTS_TEST_BYTES"); err != nil {
+			errs.Add("bytes", err)
+		} else if ok {
+			x.Bytes = v.Bytes()
+		}
+	}
+	if x.Enum == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(8), "DEFAULTS_TEST_ENUM:-TWO"); err != nil {
+			errs.Add("enum", err)
+		} else if ok {
+			x.Enum = Types_Enum(v.Enum())
+		}
+	}
+	if x.Optional == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(9), "DEFAULTS_TEST_OPTIONAL"); err != nil {
+			errs.Add("optional", err)
+		} else if ok {
+			x.Optional = func(v string) *string { return &v }(v.String())
+		}
+	}
+	if x.Timeout == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(10), "DEFAULTS_TEST_TIMEOUT:-30s"); err != nil {
+			errs.Add("timeout", err)
+		} else if ok {
+			x.Timeout = v.Message().Interface().(*durationpb.Duration)
+		}
+	}
+	if x.NotBefore == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(11), "DEFAULTS_TEST_NOT_BEFORE"); err != nil {
+			errs.Add("not_before", err)
+		} else if ok {
+			x.NotBefore = v.Message().Interface().(*timestamppb.Timestamp)
+		}
+	}
+	if x.Int64Value == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(12), "DEFAULTS_TEST_INT64_VALUE"); err != nil {
+			errs.Add("int64_value", err)
+		} else if ok {
+			x.Int64Value = v.Message().Interface().(*wrapperspb.Int64Value)
+		}
+	}
+	if x.Invalid == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(13), "DEFAULTS_TEST_INVALID"); err != nil {
+			errs.Add("invalid", err)
+		} else if ok {
+			x.Invalid = int32(v.Int())
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Env) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Int32 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(1), "DEFAULTS_TEST_INT32"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "int32", Err: err})
+		} else if ok {
+			x.Int32 = int32(v.Int())
+			paths = append(paths, "int32")
+		}
+	}
+	if x.Uint64 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(2), "DEFAULTS_TEST_UINT64:-42"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "uint64", Err: err})
+		} else if ok {
+			x.Uint64 = v.Uint()
+			paths = append(paths, "uint64")
+		}
+	}
+	if x.Sfixed64 == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(3), "DEFAULTS_TEST_SFIXED64"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "sfixed64", Err: err})
+		} else if ok {
+			x.Sfixed64 = v.Int()
+			paths = append(paths, "sfixed64")
+		}
+	}
+	if x.Float == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(4), "DEFAULTS_TEST_FLOAT:-0.42"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "float", Err: err})
+		} else if ok {
+			x.Float = float32(v.Float())
+			paths = append(paths, "float")
+		}
+	}
+	if x.Bool == false {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(5), "DEFAULTS_TEST_BOOL"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "bool", Err: err})
+		} else if ok {
+			x.Bool = v.Bool()
+			paths = append(paths, "bool")
+		}
+	}
+	if x.String_ == "" {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(6), "DEFAULTS_TEST_STRING:-fallback"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "string", Err: err})
+		} else if ok {
+			x.String_ = v.String()
+			paths = append(paths, "string")
+		}
+	}
+	if len(x.Bytes) == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(7), "DEFAULTS_TEST_BYTES"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "bytes", Err: err})
+		} else if ok {
+			x.Bytes = v.Bytes()
+			paths = append(paths, "bytes")
+		}
+	}
+	if x.Enum == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(8), "DEFAULTS_TEST_ENUM:-TWO"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "enum", Err: err})
+		} else if ok {
+			x.Enum = Types_Enum(v.Enum())
+			paths = append(paths, "enum")
+		}
+	}
+	if x.Optional == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(9), "DEFAULTS_TEST_OPTIONAL"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "optional", Err: err})
+		} else if ok {
+			x.Optional = func(v string) *string { return &v }(v.String())
+			paths = append(paths, "optional")
+		}
+	}
+	if x.Timeout == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(10), "DEFAULTS_TEST_TIMEOUT:-30s"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "timeout", Err: err})
+		} else if ok {
+			x.Timeout = v.Message().Interface().(*durationpb.Duration)
+			paths = append(paths, "timeout")
+		}
+	}
+	if x.NotBefore == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(11), "DEFAULTS_TEST_NOT_BEFORE"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "not_before", Err: err})
+		} else if ok {
+			x.NotBefore = v.Message().Interface().(*timestamppb.Timestamp)
+			paths = append(paths, "not_before")
+		}
+	}
+	if x.Int64Value == nil {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(12), "DEFAULTS_TEST_INT64_VALUE"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "int64_value", Err: err})
+		} else if ok {
+			x.Int64Value = v.Message().Interface().(*wrapperspb.Int64Value)
+			paths = append(paths, "int64_value")
+		}
+	}
+	if x.Invalid == 0 {
+		if v, ok, err := defaults.EnvValue((*Env)(nil).ProtoReflect().Descriptor().Fields().ByNumber(13), "DEFAULTS_TEST_INVALID"); err != nil {
+			defaults.ErrorHandler(&defaults.FieldError{Path: "invalid", Err: err})
+		} else if ok {
+			x.Invalid = int32(v.Int())
+			paths = append(paths, "invalid")
+		}
+	}
+	return paths
+}
+
+func (x *Env) StripDefaults() {
+}
+
+func (x *Env) IsDefault() bool {
+	return true
+}
+
+func NewEnv() *Env {
+	x := &Env{}
+	x.Default()
+	return x
+}
+
+func NewEnvWith(fn func(x *Env)) *Env {
+	x := &Env{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Errors) Default() {
+	if x.Env == nil {
+		x.Env = &Env{}
+	}
+	if v, ok := interface{}(x.Env).(interface{ Default() }); ok && x.Env != nil {
+		v.Default()
+	}
+	for _, v := range x.Envs {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+}
+
+func (x *Errors) DefaultE() error {
+	var errs defaults.Errors
+	if x.Env == nil {
+		x.Env = &Env{}
+	}
+	if v, ok := interface{}(x.Env).(interface{ DefaultE() error }); ok && x.Env != nil {
+		errs.Add("env", v.DefaultE())
+	} else if v, ok := interface{}(x.Env).(interface{ Default() }); ok && x.Env != nil {
+		v.Default()
+	}
+	for k, v := range x.Envs {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ DefaultE() error }); ok && v != nil {
+			errs.AddKey("envs", k, v.DefaultE())
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Errors) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Env == nil {
+		x.Env = &Env{}
+		paths = append(paths, "env")
+	}
+	if v, ok := interface{}(x.Env).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Env != nil {
+		paths = append(paths, defaults.PrefixPaths("env", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Env).(interface{ Default() }); ok && x.Env != nil {
+		v.Default()
+	}
+	for k, v := range x.Envs {
+		if v == nil {
+			continue
+		}
+		if v, ok := interface{}(v).(interface{ DefaultReport() []defaults.FieldPath }); ok && v != nil {
+			paths = append(paths, defaults.PrefixPaths(defaults.KeyPath("envs", k), v.DefaultReport())...)
+		} else if v, ok := interface{}(v).(interface{ Default() }); ok && v != nil {
+			v.Default()
+		}
+	}
+	return paths
+}
+
+func (x *Errors) StripDefaults() {
+	for _, v := range x.Envs {
+		if s, ok := interface{}(v).(interface{ StripDefaults() }); ok && v != nil {
+			s.StripDefaults()
+		}
+	}
+	if s, ok := interface{}(x.Env).(interface{ StripDefaults() }); ok && x.Env != nil {
+		s.StripDefaults()
+	}
+	if x.Env != nil && proto.Size(x.Env) == 0 {
+		x.Env = nil
+	}
+}
+
+func (x *Errors) IsDefault() bool {
+	if d, ok := interface{}(x.Env).(interface{ IsDefault() bool }); ok && x.Env != nil && !d.IsDefault() {
+		return false
+	}
+	for _, v := range x.Envs {
+		if d, ok := interface{}(v).(interface{ IsDefault() bool }); ok && v != nil && !d.IsDefault() {
+			return false
+		}
+	}
+	return true
+}
+
+func NewErrors() *Errors {
+	x := &Errors{}
+	x.Default()
+	return x
+}
+
+func NewErrorsWith(fn func(x *Errors)) *Errors {
+	x := &Errors{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+func (x *Initialize) Default() {
+	if x.Message == nil {
+		x.Message = &Message{}
+	}
+	if v, ok := interface{}(x.Message).(interface{ Default() }); ok && x.Message != nil {
+		v.Default()
+	}
+}
+
+func (x *Initialize) DefaultE() error {
+	var errs defaults.Errors
+	if x.Message == nil {
+		x.Message = &Message{}
+	}
+	if v, ok := interface{}(x.Message).(interface{ DefaultE() error }); ok && x.Message != nil {
+		errs.Add("message", v.DefaultE())
+	} else if v, ok := interface{}(x.Message).(interface{ Default() }); ok && x.Message != nil {
+		v.Default()
+	}
+	return errs.Err()
+}
+
+func (x *Initialize) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Message == nil {
+		x.Message = &Message{}
+		paths = append(paths, "message")
+	}
+	if v, ok := interface{}(x.Message).(interface{ DefaultReport() []defaults.FieldPath }); ok && x.Message != nil {
+		paths = append(paths, defaults.PrefixPaths("message", v.DefaultReport())...)
+	} else if v, ok := interface{}(x.Message).(interface{ Default() }); ok && x.Message != nil {
+		v.Default()
+	}
+	return paths
+}
+
+func (x *Initialize) StripDefaults() {
+	if s, ok := interface{}(x.Message).(interface{ StripDefaults() }); ok && x.Message != nil {
+		s.StripDefaults()
+	}
+	if x.Message != nil && proto.Size(x.Message) == 0 {
+		x.Message = nil
+	}
+}
+
+func (x *Initialize) IsDefault() bool {
+	if d, ok := interface{}(x.Message).(interface{ IsDefault() bool }); ok && x.Message != nil && !d.IsDefault() {
+		return false
+	}
+	return true
+}
+
+func NewInitialize() *Initialize {
+	x := &Initialize{}
+	x.Default()
+	return x
+}
+
+func NewInitializeWith(fn func(x *Initialize)) *Initialize {
+	x := &Initialize{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
+
+const (
+	Literal_Policy_Default_Timeout uint32 = 10
+)
+
+func (x *Literal_Policy) Default() {
+	if x.Timeout == 0 {
+		x.Timeout = Literal_Policy_Default_Timeout
+	}
+}
+
+func (x *Literal_Policy) DefaultE() error {
+	var errs defaults.Errors
+	if x.Timeout == 0 {
+		x.Timeout = Literal_Policy_Default_Timeout
+	}
+	return errs.Err()
+}
+
+func (x *Literal_Policy) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.Timeout == 0 {
+		x.Timeout = Literal_Policy_Default_Timeout
+		paths = append(paths, "timeout")
+	}
+	return paths
+}
+
+func (x *Literal_Policy) StripDefaults() {
+	if x.Timeout == 10 {
+		x.Timeout = 0
+	}
+}
+
+func (x *Literal_Policy) IsDefault() bool {
+	if x.Timeout != 0 && x.Timeout != 10 {
+		return false
+	}
+	return true
+}
+
+func NewLiteral_Policy() *Literal_Policy {
+	x := &Literal_Policy{}
+	x.Default()
+	return x
+}
+
+func NewLiteral_PolicyWith(fn func(x *Literal_Policy)) *Literal_Policy {
+	x := &Literal_Policy{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
 }
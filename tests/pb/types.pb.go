@@ -26,7 +26,10 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -44,22 +47,25 @@ const (
 type Types_Enum int32
 
 const (
-	Types_NONE Types_Enum = 0
-	Types_ONE  Types_Enum = 1
-	Types_TWO  Types_Enum = 2
+	Types_NONE     Types_Enum = 0
+	Types_ONE      Types_Enum = 1
+	Types_TWO      Types_Enum = 2
+	Types_NEGATIVE Types_Enum = -1
 )
 
 // Enum value maps for Types_Enum.
 var (
 	Types_Enum_name = map[int32]string{
-		0: "NONE",
-		1: "ONE",
-		2: "TWO",
+		0:  "NONE",
+		1:  "ONE",
+		2:  "TWO",
+		-1: "NEGATIVE",
 	}
 	Types_Enum_value = map[string]int32{
-		"NONE": 0,
-		"ONE":  1,
-		"TWO":  2,
+		"NONE":     0,
+		"ONE":      1,
+		"TWO":      2,
+		"NEGATIVE": -1,
 	}
 )
 
@@ -96,23 +102,26 @@ type Types struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Scalar Field Types
-	Float    float32    `protobuf:"fixed32,1,opt,name=float,proto3" json:"float,omitempty"`
-	Double   float64    `protobuf:"fixed64,2,opt,name=double,proto3" json:"double,omitempty"`
-	Int32    int32      `protobuf:"varint,3,opt,name=int32,proto3" json:"int32,omitempty"`
-	Int64    int64      `protobuf:"varint,4,opt,name=int64,proto3" json:"int64,omitempty"`
-	Uint32   uint32     `protobuf:"varint,5,opt,name=uint32,proto3" json:"uint32,omitempty"`
-	Uint64   uint64     `protobuf:"varint,6,opt,name=uint64,proto3" json:"uint64,omitempty"`
-	Sint32   int32      `protobuf:"zigzag32,7,opt,name=sint32,proto3" json:"sint32,omitempty"`
-	Sint64   int64      `protobuf:"zigzag64,8,opt,name=sint64,proto3" json:"sint64,omitempty"`
-	Fixed32  uint32     `protobuf:"fixed32,9,opt,name=fixed32,proto3" json:"fixed32,omitempty"`
-	Fixed64  uint64     `protobuf:"fixed64,10,opt,name=fixed64,proto3" json:"fixed64,omitempty"`
-	Sfixed32 int32      `protobuf:"fixed32,11,opt,name=sfixed32,proto3" json:"sfixed32,omitempty"`
-	Sfixed64 int64      `protobuf:"fixed64,12,opt,name=sfixed64,proto3" json:"sfixed64,omitempty"`
-	Bool     bool       `protobuf:"varint,13,opt,name=bool,proto3" json:"bool,omitempty"`
-	String_  string     `protobuf:"bytes,14,opt,name=string,proto3" json:"string,omitempty"`
-	Bytes    []byte     `protobuf:"bytes,15,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Enum     Types_Enum `protobuf:"varint,16,opt,name=enum,proto3,enum=tests.Types_Enum" json:"enum,omitempty"`
-	Message  *Message   `protobuf:"bytes,17,opt,name=message,proto3" json:"message,omitempty"`
+	Float            float32     `protobuf:"fixed32,1,opt,name=float,proto3" json:"float,omitempty"`
+	Double           float64     `protobuf:"fixed64,2,opt,name=double,proto3" json:"double,omitempty"`
+	Int32            int32       `protobuf:"varint,3,opt,name=int32,proto3" json:"int32,omitempty"`
+	Int64            int64       `protobuf:"varint,4,opt,name=int64,proto3" json:"int64,omitempty"`
+	Uint32           uint32      `protobuf:"varint,5,opt,name=uint32,proto3" json:"uint32,omitempty"`
+	Uint64           uint64      `protobuf:"varint,6,opt,name=uint64,proto3" json:"uint64,omitempty"`
+	Sint32           int32       `protobuf:"zigzag32,7,opt,name=sint32,proto3" json:"sint32,omitempty"`
+	Sint64           int64       `protobuf:"zigzag64,8,opt,name=sint64,proto3" json:"sint64,omitempty"`
+	Fixed32          uint32      `protobuf:"fixed32,9,opt,name=fixed32,proto3" json:"fixed32,omitempty"`
+	Fixed64          uint64      `protobuf:"fixed64,10,opt,name=fixed64,proto3" json:"fixed64,omitempty"`
+	Sfixed32         int32       `protobuf:"fixed32,11,opt,name=sfixed32,proto3" json:"sfixed32,omitempty"`
+	Sfixed64         int64       `protobuf:"fixed64,12,opt,name=sfixed64,proto3" json:"sfixed64,omitempty"`
+	Bool             bool        `protobuf:"varint,13,opt,name=bool,proto3" json:"bool,omitempty"`
+	String_          string      `protobuf:"bytes,14,opt,name=string,proto3" json:"string,omitempty"`
+	Bytes            []byte      `protobuf:"bytes,15,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Enum             Types_Enum  `protobuf:"varint,16,opt,name=enum,proto3,enum=tests.Types_Enum" json:"enum,omitempty"`
+	EnumName         Types_Enum  `protobuf:"varint,33,opt,name=enum_name,json=enumName,proto3,enum=tests.Types_Enum" json:"enum_name,omitempty"`
+	EnumFullName     Types_Enum  `protobuf:"varint,34,opt,name=enum_full_name,json=enumFullName,proto3,enum=tests.Types_Enum" json:"enum_full_name,omitempty"`
+	OptionalEnumName *Types_Enum `protobuf:"varint,35,opt,name=optional_enum_name,json=optionalEnumName,proto3,enum=tests.Types_Enum,oneof" json:"optional_enum_name,omitempty"`
+	Message          *Message    `protobuf:"bytes,17,opt,name=message,proto3" json:"message,omitempty"`
 	// Types that are assignable to Oneof:
 	//
 	//	*Types_One
@@ -132,6 +141,11 @@ type Types struct {
 	BoolValue   *wrapperspb.BoolValue   `protobuf:"bytes,26,opt,name=bool_value,json=boolValue,proto3" json:"bool_value,omitempty"`
 	StringValue *wrapperspb.StringValue `protobuf:"bytes,27,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
 	BytesValue  *wrapperspb.BytesValue  `protobuf:"bytes,28,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+	Any         *anypb.Any              `protobuf:"bytes,36,opt,name=any,proto3" json:"any,omitempty"`
+	AnyJson     *anypb.Any              `protobuf:"bytes,37,opt,name=any_json,json=anyJson,proto3" json:"any_json,omitempty"`
+	Struct      *structpb.Struct        `protobuf:"bytes,38,opt,name=struct,proto3" json:"struct,omitempty"`
+	Value       *structpb.Value         `protobuf:"bytes,39,opt,name=value,proto3" json:"value,omitempty"`
+	ListValue   *structpb.ListValue     `protobuf:"bytes,40,opt,name=list_value,json=listValue,proto3" json:"list_value,omitempty"`
 }
 
 func (x *Types) Reset() {
@@ -278,6 +292,27 @@ func (x *Types) GetEnum() Types_Enum {
 	return Types_NONE
 }
 
+func (x *Types) GetEnumName() Types_Enum {
+	if x != nil {
+		return x.EnumName
+	}
+	return Types_NONE
+}
+
+func (x *Types) GetEnumFullName() Types_Enum {
+	if x != nil {
+		return x.EnumFullName
+	}
+	return Types_NONE
+}
+
+func (x *Types) GetOptionalEnumName() Types_Enum {
+	if x != nil && x.OptionalEnumName != nil {
+		return *x.OptionalEnumName
+	}
+	return Types_NONE
+}
+
 func (x *Types) GetMessage() *Message {
 	if x != nil {
 		return x.Message
@@ -397,6 +432,41 @@ func (x *Types) GetBytesValue() *wrapperspb.BytesValue {
 	return nil
 }
 
+func (x *Types) GetAny() *anypb.Any {
+	if x != nil {
+		return x.Any
+	}
+	return nil
+}
+
+func (x *Types) GetAnyJson() *anypb.Any {
+	if x != nil {
+		return x.AnyJson
+	}
+	return nil
+}
+
+func (x *Types) GetStruct() *structpb.Struct {
+	if x != nil {
+		return x.Struct
+	}
+	return nil
+}
+
+func (x *Types) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+func (x *Types) GetListValue() *structpb.ListValue {
+	if x != nil {
+		return x.ListValue
+	}
+	return nil
+}
+
 type isTypes_Oneof interface {
 	isTypes_Oneof()
 }
@@ -613,274 +683,1864 @@ func (x *OneOfThree) GetStringField() string {
 	return ""
 }
 
-var File_tests_pb_types_proto protoreflect.FileDescriptor
+type Repeated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_tests_pb_types_proto_rawDesc = []byte{
-	0x0a, 0x14, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x64,
-	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x0c, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65,
-	0x73, 0x12, 0x1e, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x08, 0x9a, 0x49, 0x05, 0x0d, 0x3d, 0x0a, 0xd7, 0x3e, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x12, 0x24, 0x0a, 0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x0c, 0x9a, 0x49, 0x09, 0x11, 0xe1, 0x7a, 0x14, 0xae, 0x47, 0xe1, 0xda, 0x3f, 0x52,
-	0x06, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x18, 0x2a, 0x52, 0x05, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x12, 0x1b, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x20, 0x2a, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x12, 0x1d, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x28, 0x2a, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x12, 0x1d, 0x0a, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x42, 0x05, 0x9a, 0x49, 0x02, 0x30, 0x2a, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12,
-	0x1d, 0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x11, 0x42,
-	0x05, 0x9a, 0x49, 0x02, 0x38, 0x54, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1d,
-	0x0a, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x12, 0x42, 0x05,
-	0x9a, 0x49, 0x02, 0x40, 0x54, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x22, 0x0a,
-	0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x42, 0x08,
-	0x9a, 0x49, 0x05, 0x4d, 0x2a, 0x00, 0x00, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x12, 0x26, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x06, 0x42, 0x0c, 0x9a, 0x49, 0x09, 0x51, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x24, 0x0a, 0x08, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x08, 0x9a, 0x49, 0x05,
-	0x5d, 0x2a, 0x00, 0x00, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12,
-	0x28, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x10, 0x42, 0x0c, 0x9a, 0x49, 0x09, 0x61, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52,
-	0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x04, 0x62, 0x6f, 0x6f,
-	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x68, 0x01, 0x52, 0x04,
-	0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x9a, 0x49, 0x04, 0x72, 0x02, 0x34, 0x32, 0x52, 0x06, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1d, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0x9a, 0x49, 0x04, 0x7a, 0x02, 0x34, 0x32, 0x52, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x10, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x06, 0x9a, 0x49, 0x03, 0x80, 0x01, 0x01, 0x52, 0x04, 0x65,
-	0x6e, 0x75, 0x6d, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x00,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x6f, 0x6e, 0x65,
-	0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4f,
-	0x6e, 0x65, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04, 0x08,
-	0x01, 0x10, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6f, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x74, 0x77,
-	0x6f, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
-	0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x77, 0x6f, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04,
-	0x08, 0x01, 0x10, 0x01, 0x48, 0x00, 0x52, 0x03, 0x74, 0x77, 0x6f, 0x12, 0x35, 0x0a, 0x05, 0x74,
-	0x68, 0x72, 0x65, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x72, 0x65, 0x65, 0x42, 0x0a, 0x9a,
-	0x49, 0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x01, 0x48, 0x00, 0x52, 0x05, 0x74, 0x68, 0x72,
-	0x65, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x6f, 0x75, 0x72, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x42, 0x06, 0x9a, 0x49, 0x03, 0x80, 0x01, 0x01, 0x48, 0x00, 0x52, 0x04, 0x66,
-	0x6f, 0x75, 0x72, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x08, 0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x64, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x42, 0x09, 0x9a, 0x49, 0x06, 0xb2, 0x01, 0x03, 0x6e, 0x6f, 0x77, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x9a,
-	0x49, 0x09, 0x11, 0xe1, 0x7a, 0x14, 0xae, 0x47, 0xe1, 0xda, 0x3f, 0x52, 0x0b, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x9a, 0x49, 0x05, 0x0d,
-	0x3d, 0x0a, 0xd7, 0x3e, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x12, 0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x20, 0x2a, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x30, 0x2a,
-	0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43, 0x0a,
-	0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x05, 0x9a, 0x49, 0x02, 0x18, 0x2a, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x28, 0x2a, 0x52, 0x0b, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x62, 0x6f,
-	0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x68,
-	0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a, 0x0c,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1b, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x42, 0x07, 0x9a, 0x49, 0x04, 0x72, 0x02, 0x34, 0x32, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x9a, 0x49, 0x04, 0x7a, 0x02, 0x34,
-	0x32, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x22, 0x0a,
-	0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12,
-	0x07, 0x0a, 0x03, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x57, 0x4f, 0x10,
-	0x02, 0x42, 0x0f, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x06, 0x9a, 0x49, 0x03, 0x74,
-	0x77, 0x6f, 0x22, 0x32, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x49,
-	0x0e, 0x72, 0x0c, 0x6c, 0x6f, 0x6e, 0x65, 0x6c, 0x79, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x45, 0x0a, 0x08, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x4f,
-	0x6e, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x72, 0x0c, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x03, 0xa0, 0x49, 0x01, 0x22, 0x40, 0x0a,
-	0x08, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x77, 0x6f, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x11, 0x9a, 0x49, 0x0e, 0x72, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
-	0x47, 0x0a, 0x0a, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x34, 0x0a,
-	0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x72, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x3a, 0x03, 0x98, 0x49, 0x01, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x6c,
-	0x69, 0x6e, 0x6b, 0x61, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x74,
-	0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	Strings      []string                  `protobuf:"bytes,1,rep,name=strings,proto3" json:"strings,omitempty"`
+	Numbers      []int64                   `protobuf:"varint,2,rep,packed,name=numbers,proto3" json:"numbers,omitempty"`
+	Fixed64S     []uint64                  `protobuf:"fixed64,3,rep,packed,name=fixed64s,proto3" json:"fixed64s,omitempty"`
+	Bools        []bool                    `protobuf:"varint,4,rep,packed,name=bools,proto3" json:"bools,omitempty"`
+	Bytes        [][]byte                  `protobuf:"bytes,5,rep,name=bytes,proto3" json:"bytes,omitempty"`
+	Enums        []Types_Enum              `protobuf:"varint,6,rep,packed,name=enums,proto3,enum=tests.Types_Enum" json:"enums,omitempty"`
+	StringValues []*wrapperspb.StringValue `protobuf:"bytes,7,rep,name=string_values,json=stringValues,proto3" json:"string_values,omitempty"`
+	Durations    []*durationpb.Duration    `protobuf:"bytes,8,rep,name=durations,proto3" json:"durations,omitempty"`
+	Timestamps   []*timestamppb.Timestamp  `protobuf:"bytes,9,rep,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Messages     []*Message                `protobuf:"bytes,10,rep,name=messages,proto3" json:"messages,omitempty"`
 }
 
-var (
-	file_tests_pb_types_proto_rawDescOnce sync.Once
-	file_tests_pb_types_proto_rawDescData = file_tests_pb_types_proto_rawDesc
-)
+func (x *Repeated) Reset() {
+	*x = Repeated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_tests_pb_types_proto_rawDescGZIP() []byte {
-	file_tests_pb_types_proto_rawDescOnce.Do(func() {
-		file_tests_pb_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_types_proto_rawDescData)
-	})
-	return file_tests_pb_types_proto_rawDescData
+func (x *Repeated) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_tests_pb_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_tests_pb_types_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_tests_pb_types_proto_goTypes = []interface{}{
-	(Types_Enum)(0),                // 0: tests.Types.Enum
-	(*Types)(nil),                  // 1: tests.Types
-	(*Message)(nil),                // 2: tests.Message
-	(*OneOfOne)(nil),               // 3: tests.OneOfOne
-	(*OneOfTwo)(nil),               // 4: tests.OneOfTwo
-	(*OneOfThree)(nil),             // 5: tests.OneOfThree
-	(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),  // 7: google.protobuf.Timestamp
-	(*wrapperspb.DoubleValue)(nil), // 8: google.protobuf.DoubleValue
-	(*wrapperspb.FloatValue)(nil),  // 9: google.protobuf.FloatValue
-	(*wrapperspb.Int64Value)(nil),  // 10: google.protobuf.Int64Value
-	(*wrapperspb.UInt64Value)(nil), // 11: google.protobuf.UInt64Value
-	(*wrapperspb.Int32Value)(nil),  // 12: google.protobuf.Int32Value
-	(*wrapperspb.UInt32Value)(nil), // 13: google.protobuf.UInt32Value
-	(*wrapperspb.BoolValue)(nil),   // 14: google.protobuf.BoolValue
-	(*wrapperspb.StringValue)(nil), // 15: google.protobuf.StringValue
-	(*wrapperspb.BytesValue)(nil),  // 16: google.protobuf.BytesValue
+func (*Repeated) ProtoMessage() {}
+
+func (x *Repeated) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_tests_pb_types_proto_depIdxs = []int32{
-	0,  // 0: tests.Types.enum:type_name -> tests.Types.Enum
-	2,  // 1: tests.Types.message:type_name -> tests.Message
-	3,  // 2: tests.Types.one:type_name -> tests.OneOfOne
-	4,  // 3: tests.Types.two:type_name -> tests.OneOfTwo
-	5,  // 4: tests.Types.three:type_name -> tests.OneOfThree
-	0,  // 5: tests.Types.four:type_name -> tests.Types.Enum
-	6,  // 6: tests.Types.duration:type_name -> google.protobuf.Duration
-	7,  // 7: tests.Types.timestamp:type_name -> google.protobuf.Timestamp
-	8,  // 8: tests.Types.double_value:type_name -> google.protobuf.DoubleValue
-	9,  // 9: tests.Types.float_value:type_name -> google.protobuf.FloatValue
-	10, // 10: tests.Types.int64_value:type_name -> google.protobuf.Int64Value
-	11, // 11: tests.Types.uint64_value:type_name -> google.protobuf.UInt64Value
-	12, // 12: tests.Types.int32_value:type_name -> google.protobuf.Int32Value
-	13, // 13: tests.Types.uint32_value:type_name -> google.protobuf.UInt32Value
-	14, // 14: tests.Types.bool_value:type_name -> google.protobuf.BoolValue
-	15, // 15: tests.Types.string_value:type_name -> google.protobuf.StringValue
-	16, // 16: tests.Types.bytes_value:type_name -> google.protobuf.BytesValue
-	17, // [17:17] is the sub-list for method output_type
-	17, // [17:17] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+
+// Deprecated: Use Repeated.ProtoReflect.Descriptor instead.
+func (*Repeated) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{5}
 }
 
-func init() { file_tests_pb_types_proto_init() }
-func file_tests_pb_types_proto_init() {
-	if File_tests_pb_types_proto != nil {
-		return
+func (x *Repeated) GetStrings() []string {
+	if x != nil {
+		return x.Strings
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_tests_pb_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Types); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tests_pb_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tests_pb_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OneOfOne); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tests_pb_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OneOfTwo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_tests_pb_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OneOfThree); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+func (x *Repeated) GetNumbers() []int64 {
+	if x != nil {
+		return x.Numbers
+	}
+	return nil
+}
+
+func (x *Repeated) GetFixed64S() []uint64 {
+	if x != nil {
+		return x.Fixed64S
+	}
+	return nil
+}
+
+func (x *Repeated) GetBools() []bool {
+	if x != nil {
+		return x.Bools
+	}
+	return nil
+}
+
+func (x *Repeated) GetBytes() [][]byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Repeated) GetEnums() []Types_Enum {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+func (x *Repeated) GetStringValues() []*wrapperspb.StringValue {
+	if x != nil {
+		return x.StringValues
+	}
+	return nil
+}
+
+func (x *Repeated) GetDurations() []*durationpb.Duration {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *Repeated) GetTimestamps() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamps
+	}
+	return nil
+}
+
+func (x *Repeated) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type Maps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Labels    map[string]string             `protobuf:"bytes,1,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Merged    map[string]string             `protobuf:"bytes,2,rep,name=merged,proto3" json:"merged,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Enums     map[int32]Types_Enum          `protobuf:"bytes,3,rep,name=enums,proto3" json:"enums,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=tests.Types_Enum"`
+	Durations map[bool]*durationpb.Duration `protobuf:"bytes,4,rep,name=durations,proto3" json:"durations,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Messages  map[string]*Message           `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Maps) Reset() {
+	*x = Maps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Maps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Maps) ProtoMessage() {}
+
+func (x *Maps) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	file_tests_pb_types_proto_msgTypes[0].OneofWrappers = []interface{}{
-		(*Types_One)(nil),
-		(*Types_Two)(nil),
-		(*Types_Three)(nil),
-		(*Types_Four)(nil),
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Maps.ProtoReflect.Descriptor instead.
+func (*Maps) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Maps) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Maps) GetMerged() map[string]string {
+	if x != nil {
+		return x.Merged
+	}
+	return nil
+}
+
+func (x *Maps) GetEnums() map[int32]Types_Enum {
+	if x != nil {
+		return x.Enums
+	}
+	return nil
+}
+
+func (x *Maps) GetDurations() map[bool]*durationpb.Duration {
+	if x != nil {
+		return x.Durations
+	}
+	return nil
+}
+
+func (x *Maps) GetMessages() map[string]*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+type Elements struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Messages    []*Message          `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty"`
+	Initialized []*Message          `protobuf:"bytes,2,rep,name=initialized,proto3" json:"initialized,omitempty"`
+	Values      map[string]*Message `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Elements) Reset() {
+	*x = Elements{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Elements) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Elements) ProtoMessage() {}
+
+func (x *Elements) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Elements.ProtoReflect.Descriptor instead.
+func (*Elements) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Elements) GetMessages() []*Message {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *Elements) GetInitialized() []*Message {
+	if x != nil {
+		return x.Initialized
+	}
+	return nil
+}
+
+func (x *Elements) GetValues() map[string]*Message {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type Literal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text   *Literal_Policy            `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Json   *Literal_Policy            `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	Items  []*Literal_Policy          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Values map[string]*Literal_Policy `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Literal) Reset() {
+	*x = Literal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Literal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Literal) ProtoMessage() {}
+
+func (x *Literal) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Literal.ProtoReflect.Descriptor instead.
+func (*Literal) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Literal) GetText() *Literal_Policy {
+	if x != nil {
+		return x.Text
+	}
+	return nil
+}
+
+func (x *Literal) GetJson() *Literal_Policy {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+func (x *Literal) GetItems() []*Literal_Policy {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Literal) GetValues() map[string]*Literal_Policy {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LiteralExtension struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Options *descriptorpb.FieldOptions `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *LiteralExtension) Reset() {
+	*x = LiteralExtension{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiteralExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiteralExtension) ProtoMessage() {}
+
+func (x *LiteralExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LiteralExtension.ProtoReflect.Descriptor instead.
+func (*LiteralExtension) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LiteralExtension) GetOptions() *descriptorpb.FieldOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type Generated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UuidV4      string                  `protobuf:"bytes,1,opt,name=uuid_v4,json=uuidV4,proto3" json:"uuid_v4,omitempty"`
+	UuidV7      string                  `protobuf:"bytes,2,opt,name=uuid_v7,json=uuidV7,proto3" json:"uuid_v7,omitempty"`
+	Ulid        string                  `protobuf:"bytes,3,opt,name=ulid,proto3" json:"ulid,omitempty"`
+	Xid         string                  `protobuf:"bytes,4,opt,name=xid,proto3" json:"xid,omitempty"`
+	ObjectId    string                  `protobuf:"bytes,5,opt,name=object_id,json=objectId,proto3" json:"object_id,omitempty"`
+	RandomHex   string                  `protobuf:"bytes,6,opt,name=random_hex,json=randomHex,proto3" json:"random_hex,omitempty"`
+	Hostname    string                  `protobuf:"bytes,7,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	UuidBytes   []byte                  `protobuf:"bytes,8,opt,name=uuid_bytes,json=uuidBytes,proto3" json:"uuid_bytes,omitempty"`
+	RandomBytes []byte                  `protobuf:"bytes,9,opt,name=random_bytes,json=randomBytes,proto3" json:"random_bytes,omitempty"`
+	OptionalId  *string                 `protobuf:"bytes,10,opt,name=optional_id,json=optionalId,proto3,oneof" json:"optional_id,omitempty"`
+	StringValue *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=string_value,json=stringValue,proto3" json:"string_value,omitempty"`
+	BytesValue  *wrapperspb.BytesValue  `protobuf:"bytes,12,opt,name=bytes_value,json=bytesValue,proto3" json:"bytes_value,omitempty"`
+}
+
+func (x *Generated) Reset() {
+	*x = Generated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Generated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Generated) ProtoMessage() {}
+
+func (x *Generated) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Generated.ProtoReflect.Descriptor instead.
+func (*Generated) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Generated) GetUuidV4() string {
+	if x != nil {
+		return x.UuidV4
+	}
+	return ""
+}
+
+func (x *Generated) GetUuidV7() string {
+	if x != nil {
+		return x.UuidV7
+	}
+	return ""
+}
+
+func (x *Generated) GetUlid() string {
+	if x != nil {
+		return x.Ulid
+	}
+	return ""
+}
+
+func (x *Generated) GetXid() string {
+	if x != nil {
+		return x.Xid
+	}
+	return ""
+}
+
+func (x *Generated) GetObjectId() string {
+	if x != nil {
+		return x.ObjectId
+	}
+	return ""
+}
+
+func (x *Generated) GetRandomHex() string {
+	if x != nil {
+		return x.RandomHex
+	}
+	return ""
+}
+
+func (x *Generated) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Generated) GetUuidBytes() []byte {
+	if x != nil {
+		return x.UuidBytes
+	}
+	return nil
+}
+
+func (x *Generated) GetRandomBytes() []byte {
+	if x != nil {
+		return x.RandomBytes
+	}
+	return nil
+}
+
+func (x *Generated) GetOptionalId() string {
+	if x != nil && x.OptionalId != nil {
+		return *x.OptionalId
+	}
+	return ""
+}
+
+func (x *Generated) GetStringValue() *wrapperspb.StringValue {
+	if x != nil {
+		return x.StringValue
+	}
+	return nil
+}
+
+func (x *Generated) GetBytesValue() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.BytesValue
+	}
+	return nil
+}
+
+type Timestamps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresAt *timestamppb.Timestamp   `protobuf:"bytes,1,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	NotBefore *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	Today     *timestamppb.Timestamp   `protobuf:"bytes,3,opt,name=today,proto3" json:"today,omitempty"`
+	Tomorrow  *timestamppb.Timestamp   `protobuf:"bytes,4,opt,name=tomorrow,proto3" json:"tomorrow,omitempty"`
+	Morning   *timestamppb.Timestamp   `protobuf:"bytes,5,opt,name=morning,proto3" json:"morning,omitempty"`
+	Windows   []*timestamppb.Timestamp `protobuf:"bytes,6,rep,name=windows,proto3" json:"windows,omitempty"`
+}
+
+func (x *Timestamps) Reset() {
+	*x = Timestamps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timestamps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timestamps) ProtoMessage() {}
+
+func (x *Timestamps) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timestamps.ProtoReflect.Descriptor instead.
+func (*Timestamps) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Timestamps) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Timestamps) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *Timestamps) GetToday() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Today
+	}
+	return nil
+}
+
+func (x *Timestamps) GetTomorrow() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Tomorrow
+	}
+	return nil
+}
+
+func (x *Timestamps) GetMorning() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Morning
+	}
+	return nil
+}
+
+func (x *Timestamps) GetWindows() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type Env struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Int32      int32                  `protobuf:"varint,1,opt,name=int32,proto3" json:"int32,omitempty"`
+	Uint64     uint64                 `protobuf:"varint,2,opt,name=uint64,proto3" json:"uint64,omitempty"`
+	Sfixed64   int64                  `protobuf:"fixed64,3,opt,name=sfixed64,proto3" json:"sfixed64,omitempty"`
+	Float      float32                `protobuf:"fixed32,4,opt,name=float,proto3" json:"float,omitempty"`
+	Bool       bool                   `protobuf:"varint,5,opt,name=bool,proto3" json:"bool,omitempty"`
+	String_    string                 `protobuf:"bytes,6,opt,name=string,proto3" json:"string,omitempty"`
+	Bytes      []byte                 `protobuf:"bytes,7,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Enum       Types_Enum             `protobuf:"varint,8,opt,name=enum,proto3,enum=tests.Types_Enum" json:"enum,omitempty"`
+	Optional   *string                `protobuf:"bytes,9,opt,name=optional,proto3,oneof" json:"optional,omitempty"`
+	Timeout    *durationpb.Duration   `protobuf:"bytes,10,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	NotBefore  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	Int64Value *wrapperspb.Int64Value `protobuf:"bytes,12,opt,name=int64_value,json=int64Value,proto3" json:"int64_value,omitempty"`
+	Invalid    int32                  `protobuf:"varint,13,opt,name=invalid,proto3" json:"invalid,omitempty"`
+}
+
+func (x *Env) Reset() {
+	*x = Env{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Env) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Env) ProtoMessage() {}
+
+func (x *Env) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Env.ProtoReflect.Descriptor instead.
+func (*Env) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Env) GetInt32() int32 {
+	if x != nil {
+		return x.Int32
+	}
+	return 0
+}
+
+func (x *Env) GetUint64() uint64 {
+	if x != nil {
+		return x.Uint64
+	}
+	return 0
+}
+
+func (x *Env) GetSfixed64() int64 {
+	if x != nil {
+		return x.Sfixed64
+	}
+	return 0
+}
+
+func (x *Env) GetFloat() float32 {
+	if x != nil {
+		return x.Float
+	}
+	return 0
+}
+
+func (x *Env) GetBool() bool {
+	if x != nil {
+		return x.Bool
+	}
+	return false
+}
+
+func (x *Env) GetString_() string {
+	if x != nil {
+		return x.String_
+	}
+	return ""
+}
+
+func (x *Env) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+func (x *Env) GetEnum() Types_Enum {
+	if x != nil {
+		return x.Enum
+	}
+	return Types_NONE
+}
+
+func (x *Env) GetOptional() string {
+	if x != nil && x.Optional != nil {
+		return *x.Optional
+	}
+	return ""
+}
+
+func (x *Env) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Env) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *Env) GetInt64Value() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Int64Value
+	}
+	return nil
+}
+
+func (x *Env) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+type Errors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Env  *Env            `protobuf:"bytes,1,opt,name=env,proto3" json:"env,omitempty"`
+	Envs map[string]*Env `protobuf:"bytes,2,rep,name=envs,proto3" json:"envs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Errors) Reset() {
+	*x = Errors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Errors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Errors) ProtoMessage() {}
+
+func (x *Errors) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Errors.ProtoReflect.Descriptor instead.
+func (*Errors) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *Errors) GetEnv() *Env {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Errors) GetEnvs() map[string]*Env {
+	if x != nil {
+		return x.Envs
+	}
+	return nil
+}
+
+type Initialize struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Initialize) Reset() {
+	*x = Initialize{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Initialize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Initialize) ProtoMessage() {}
+
+func (x *Initialize) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Initialize.ProtoReflect.Descriptor instead.
+func (*Initialize) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Initialize) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Literal_Policy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAttempts uint32               `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff     *durationpb.Duration `protobuf:"bytes,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	Codes       []string             `protobuf:"bytes,3,rep,name=codes,proto3" json:"codes,omitempty"`
+	Weights     map[string]int32     `protobuf:"bytes,4,rep,name=weights,proto3" json:"weights,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Enum        Types_Enum           `protobuf:"varint,5,opt,name=enum,proto3,enum=tests.Types_Enum" json:"enum,omitempty"`
+	Name        *string              `protobuf:"bytes,6,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// Types that are assignable to Kind:
+	//
+	//	*Literal_Policy_Label
+	//	*Literal_Policy_Message
+	Kind    isLiteral_Policy_Kind `protobuf_oneof:"kind"`
+	Nested  *Message              `protobuf:"bytes,9,opt,name=nested,proto3" json:"nested,omitempty"`
+	Data    []byte                `protobuf:"bytes,10,opt,name=data,proto3" json:"data,omitempty"`
+	Ratio   float64               `protobuf:"fixed64,11,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Timeout uint32                `protobuf:"varint,12,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *Literal_Policy) Reset() {
+	*x = Literal_Policy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_types_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Literal_Policy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Literal_Policy) ProtoMessage() {}
+
+func (x *Literal_Policy) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_types_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Literal_Policy.ProtoReflect.Descriptor instead.
+func (*Literal_Policy) Descriptor() ([]byte, []int) {
+	return file_tests_pb_types_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Literal_Policy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *Literal_Policy) GetBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.Backoff
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetWeights() map[string]int32 {
+	if x != nil {
+		return x.Weights
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetEnum() Types_Enum {
+	if x != nil {
+		return x.Enum
+	}
+	return Types_NONE
+}
+
+func (x *Literal_Policy) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (m *Literal_Policy) GetKind() isLiteral_Policy_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetLabel() string {
+	if x, ok := x.GetKind().(*Literal_Policy_Label); ok {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Literal_Policy) GetMessage() *Message {
+	if x, ok := x.GetKind().(*Literal_Policy_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetNested() *Message {
+	if x != nil {
+		return x.Nested
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Literal_Policy) GetRatio() float64 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
+func (x *Literal_Policy) GetTimeout() uint32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+type isLiteral_Policy_Kind interface {
+	isLiteral_Policy_Kind()
+}
+
+type Literal_Policy_Label struct {
+	Label string `protobuf:"bytes,7,opt,name=label,proto3,oneof"`
+}
+
+type Literal_Policy_Message struct {
+	Message *Message `protobuf:"bytes,8,opt,name=message,proto3,oneof"`
+}
+
+func (*Literal_Policy_Label) isLiteral_Policy_Kind() {}
+
+func (*Literal_Policy_Message) isLiteral_Policy_Kind() {}
+
+var File_tests_pb_types_proto protoreflect.FileDescriptor
+
+var file_tests_pb_types_proto_rawDesc = []byte{
+	0x0a, 0x14, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xf6, 0x12, 0x0a, 0x05, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x05,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42, 0x08, 0x9a, 0x49, 0x05,
+	0x0d, 0x3d, 0x0a, 0xd7, 0x3e, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x06,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0c, 0x9a, 0x49,
+	0x09, 0x11, 0xe1, 0x7a, 0x14, 0xae, 0x47, 0xe1, 0xda, 0x3f, 0x52, 0x06, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x18, 0x2a, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x1b, 0x0a, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05,
+	0x9a, 0x49, 0x02, 0x20, 0x2a, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x1d, 0x0a, 0x06,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x05, 0x9a, 0x49,
+	0x02, 0x28, 0x2a, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1d, 0x0a, 0x06, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x05, 0x9a, 0x49, 0x02,
+	0x30, 0x2a, 0x52, 0x06, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x1d, 0x0a, 0x06, 0x73, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x07, 0x20, 0x01, 0x28, 0x11, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x38,
+	0x54, 0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1d, 0x0a, 0x06, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01, 0x28, 0x12, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x40, 0x54,
+	0x52, 0x06, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x22, 0x0a, 0x07, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x18, 0x09, 0x20, 0x01, 0x28, 0x07, 0x42, 0x08, 0x9a, 0x49, 0x05, 0x4d, 0x2a,
+	0x00, 0x00, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x26, 0x0a, 0x07,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0c, 0x9a,
+	0x49, 0x09, 0x51, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x07, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x12, 0x24, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x08, 0x9a, 0x49, 0x05, 0x5d, 0x2a, 0x00, 0x00, 0x00,
+	0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x28, 0x0a, 0x08, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0c, 0x9a, 0x49,
+	0x09, 0x61, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x12, 0x19, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x68, 0x01, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12,
+	0x1f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0x9a, 0x49, 0x04, 0x72, 0x02, 0x34, 0x32, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x1d, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x07, 0x9a, 0x49, 0x04, 0x7a, 0x02, 0x34, 0x32, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x2d, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x42, 0x06, 0x9a, 0x49, 0x03, 0x80, 0x01, 0x01, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x39,
+	0x0a, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x42, 0x09, 0x9a, 0x49, 0x06, 0xba, 0x01, 0x03, 0x54, 0x57, 0x4f, 0x52,
+	0x08, 0x65, 0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x42, 0x1a, 0x9a, 0x49, 0x17, 0xba, 0x01, 0x14, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45,
+	0x52, 0x0c, 0x65, 0x6e, 0x75, 0x6d, 0x46, 0x75, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x54,
+	0x0a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x0e, 0x9a,
+	0x49, 0x0b, 0xba, 0x01, 0x08, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x48, 0x01, 0x52,
+	0x10, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10,
+	0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x6f, 0x6e,
+	0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04,
+	0x08, 0x01, 0x10, 0x01, 0x48, 0x00, 0x52, 0x03, 0x6f, 0x6e, 0x65, 0x12, 0x2f, 0x0a, 0x03, 0x74,
+	0x77, 0x6f, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x77, 0x6f, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01,
+	0x04, 0x08, 0x01, 0x10, 0x01, 0x48, 0x00, 0x52, 0x03, 0x74, 0x77, 0x6f, 0x12, 0x35, 0x0a, 0x05,
+	0x74, 0x68, 0x72, 0x65, 0x65, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x68, 0x72, 0x65, 0x65, 0x42, 0x0a,
+	0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x01, 0x48, 0x00, 0x52, 0x05, 0x74, 0x68,
+	0x72, 0x65, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x66, 0x6f, 0x75, 0x72, 0x18, 0x20, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x42, 0x06, 0x9a, 0x49, 0x03, 0x80, 0x01, 0x01, 0x48, 0x00, 0x52, 0x04,
+	0x66, 0x6f, 0x75, 0x72, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x64, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x09, 0x9a, 0x49, 0x06, 0xb2, 0x01, 0x03, 0x6e, 0x6f, 0x77, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x4d, 0x0a, 0x0c, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c,
+	0x9a, 0x49, 0x09, 0x11, 0xe1, 0x7a, 0x14, 0xae, 0x47, 0xe1, 0xda, 0x3f, 0x52, 0x0b, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x9a, 0x49, 0x05,
+	0x0d, 0x3d, 0x0a, 0xd7, 0x3e, 0x52, 0x0a, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x12, 0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x20, 0x2a, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x30,
+	0x2a, 0x52, 0x0b, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x43,
+	0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x05, 0x9a, 0x49, 0x02, 0x18, 0x2a, 0x52, 0x0a, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x28, 0x2a, 0x52, 0x0b,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x05, 0x9a, 0x49, 0x02,
+	0x68, 0x00, 0x52, 0x09, 0x62, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x48, 0x0a,
+	0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x07, 0x9a, 0x49, 0x04, 0x72, 0x02, 0x34, 0x32, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x07, 0x9a, 0x49, 0x04, 0x7a, 0x02,
+	0x34, 0x32, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x62,
+	0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
+	0x79, 0x42, 0x3a, 0x9a, 0x49, 0x37, 0xa2, 0x01, 0x34, 0x0a, 0x21, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0f, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x3a, 0x20, 0x27, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x27, 0x52, 0x03, 0x61,
+	0x6e, 0x79, 0x12, 0x57, 0x0a, 0x08, 0x61, 0x6e, 0x79, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x25,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x26, 0x9a, 0x49, 0x23, 0xa2,
+	0x01, 0x20, 0x0a, 0x18, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x04, 0x22, 0x31,
+	0x73, 0x22, 0x52, 0x07, 0x61, 0x6e, 0x79, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0xa4, 0x01, 0x0a, 0x06,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x42, 0x73, 0x9a, 0x49, 0x70, 0xc2, 0x01, 0x6d, 0x7b, 0x22, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x3a, 0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2c,
+	0x20, 0x22, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x3a, 0x20, 0x34, 0x32, 0x2c, 0x20, 0x22,
+	0x62, 0x6f, 0x6f, 0x6c, 0x22, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65, 0x2c, 0x20, 0x22, 0x6e, 0x75,
+	0x6c, 0x6c, 0x22, 0x3a, 0x20, 0x6e, 0x75, 0x6c, 0x6c, 0x2c, 0x20, 0x22, 0x6c, 0x69, 0x73, 0x74,
+	0x22, 0x3a, 0x20, 0x5b, 0x31, 0x2c, 0x20, 0x22, 0x74, 0x77, 0x6f, 0x22, 0x5d, 0x2c, 0x20, 0x22,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x22, 0x3a, 0x20, 0x7b, 0x22, 0x6b, 0x65, 0x79, 0x22, 0x3a,
+	0x20, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7d, 0x7d, 0x52, 0x06, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x9a, 0x49, 0x0a, 0xc2, 0x01,
+	0x07, 0x22, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x57, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x28, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x1c, 0x9a, 0x49, 0x19, 0xc2, 0x01, 0x16, 0x5b, 0x30, 0x2e, 0x34, 0x32, 0x2c, 0x20, 0x7b, 0x22,
+	0x6b, 0x65, 0x79, 0x22, 0x3a, 0x20, 0x66, 0x61, 0x6c, 0x73, 0x65, 0x7d, 0x5d, 0x52, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x39, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4e, 0x45, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x4e,
+	0x45, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x57, 0x4f, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x08,
+	0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0x01, 0x42, 0x0f, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x06, 0x9a, 0x49,
+	0x03, 0x74, 0x77, 0x6f, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x72, 0x0c, 0x6c, 0x6f, 0x6e, 0x65,
+	0x6c, 0x79, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22,
+	0x45, 0x0a, 0x08, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x4f, 0x6e, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x72, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x3a, 0x03, 0xa0, 0x49, 0x01, 0x22, 0x40, 0x0a, 0x08, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54,
+	0x77, 0x6f, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x72, 0x0c, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0b, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x47, 0x0a, 0x0a, 0x4f, 0x6e, 0x65, 0x4f,
+	0x66, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x11, 0x9a, 0x49,
+	0x0e, 0x72, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x03, 0x98, 0x49,
+	0x01, 0x22, 0xf1, 0x04, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2e,
+	0x0a, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x14, 0x9a, 0x49, 0x11, 0x92, 0x01, 0x0e, 0x0a, 0x05, 0x72, 0x03, 0x6f, 0x6e, 0x65, 0x0a, 0x05,
+	0x72, 0x03, 0x74, 0x77, 0x6f, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x28,
+	0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42,
+	0x0e, 0x9a, 0x49, 0x0b, 0x92, 0x01, 0x08, 0x0a, 0x02, 0x20, 0x01, 0x0a, 0x02, 0x20, 0x02, 0x52,
+	0x07, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x06, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x92,
+	0x01, 0x0b, 0x0a, 0x09, 0x51, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x08, 0x42, 0x0e, 0x9a, 0x49, 0x0b, 0x92, 0x01, 0x08, 0x0a, 0x02,
+	0x68, 0x01, 0x0a, 0x02, 0x68, 0x00, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x22, 0x0a,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x0c, 0x9a, 0x49,
+	0x09, 0x92, 0x01, 0x06, 0x0a, 0x04, 0x7a, 0x02, 0x34, 0x32, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x55, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x42, 0x2c, 0x9a, 0x49, 0x29, 0x92, 0x01, 0x26, 0x0a, 0x03, 0x80, 0x01, 0x01,
+	0x0a, 0x06, 0xba, 0x01, 0x03, 0x54, 0x57, 0x4f, 0x0a, 0x17, 0xba, 0x01, 0x14, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4e, 0x45, 0x47, 0x41, 0x54, 0x49, 0x56,
+	0x45, 0x52, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x12, 0x4f, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x9a,
+	0x49, 0x09, 0x92, 0x01, 0x06, 0x0a, 0x04, 0x72, 0x02, 0x34, 0x32, 0x52, 0x0c, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x09, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x14, 0x9a, 0x49, 0x11, 0x92, 0x01, 0x0e, 0x0a,
+	0x05, 0xaa, 0x01, 0x02, 0x31, 0x68, 0x0a, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x64, 0x52, 0x09, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x5b, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1f, 0x9a, 0x49, 0x1c, 0x92, 0x01, 0x19,
+	0x0a, 0x17, 0xb2, 0x01, 0x14, 0x31, 0x39, 0x35, 0x32, 0x2d, 0x30, 0x33, 0x2d, 0x31, 0x31, 0x54,
+	0x30, 0x30, 0x3a, 0x30, 0x30, 0x3a, 0x30, 0x30, 0x5a, 0x52, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x3e, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x12, 0x9a, 0x49, 0x0f, 0x92, 0x01, 0x0c, 0x0a,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x0a, 0x03, 0x8a, 0x01, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xfc, 0x05, 0x0a, 0x04, 0x4d, 0x61, 0x70, 0x73, 0x12, 0x4c,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1b, 0x9a, 0x49, 0x18, 0x9a, 0x01, 0x15, 0x0a,
+	0x13, 0x0a, 0x05, 0x72, 0x03, 0x61, 0x70, 0x70, 0x12, 0x0a, 0x72, 0x08, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x55, 0x0a, 0x06,
+	0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x24, 0x9a, 0x49, 0x21, 0x9a, 0x01, 0x1e, 0x0a, 0x0c, 0x0a,
+	0x05, 0x72, 0x03, 0x6f, 0x6e, 0x65, 0x12, 0x03, 0x72, 0x01, 0x31, 0x0a, 0x0c, 0x0a, 0x05, 0x72,
+	0x03, 0x74, 0x77, 0x6f, 0x12, 0x03, 0x72, 0x01, 0x32, 0x10, 0x01, 0x52, 0x06, 0x6d, 0x65, 0x72,
+	0x67, 0x65, 0x64, 0x12, 0x3f, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x73, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x9a, 0x49, 0x0e, 0x9a,
+	0x01, 0x0b, 0x0a, 0x09, 0x0a, 0x02, 0x18, 0x01, 0x12, 0x03, 0x80, 0x01, 0x01, 0x52, 0x05, 0x65,
+	0x6e, 0x75, 0x6d, 0x73, 0x12, 0x4e, 0x0a, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x4d, 0x61, 0x70, 0x73, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x14, 0x9a, 0x49, 0x11, 0x9a, 0x01, 0x0e, 0x0a, 0x0c, 0x0a, 0x02, 0x68,
+	0x01, 0x12, 0x06, 0xaa, 0x01, 0x03, 0x33, 0x30, 0x73, 0x52, 0x09, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x55, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d,
+	0x61, 0x70, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x1e, 0x9a, 0x49, 0x1b, 0x9a, 0x01, 0x18, 0x0a, 0x10, 0x0a, 0x09, 0x72, 0x07, 0x64,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x03, 0x8a, 0x01, 0x00, 0x1a, 0x04, 0x08, 0x01, 0x10,
+	0x01, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x4b, 0x0a, 0x0a, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x57,
+	0x0a, 0x0e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x2f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x88, 0x02, 0x0a, 0x08, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x34, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x08, 0x9a, 0x49, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x0a, 0x9a, 0x49,
+	0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x01, 0x52, 0x0b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x08, 0x9a, 0x49, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0x49, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xaf, 0x08, 0x0a, 0x07, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0xe4, 0x01, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x73, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x42, 0xb8, 0x01, 0x9a, 0x49, 0xb4, 0x01, 0x8a, 0x01, 0xb0, 0x01, 0x1a, 0xad, 0x01, 0x6d,
+	0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x3a, 0x20, 0x33, 0x20, 0x62,
+	0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x20, 0x7b, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x3a,
+	0x20, 0x31, 0x7d, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x3a, 0x20, 0x5b, 0x27, 0x55, 0x4e, 0x41,
+	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x27, 0x5d, 0x20, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x20, 0x7b, 0x6b, 0x65, 0x79, 0x3a, 0x20, 0x27, 0x61, 0x27, 0x20, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x20, 0x31, 0x7d, 0x20, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x20, 0x54, 0x57, 0x4f,
+	0x20, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x20, 0x27, 0x72, 0x65, 0x74, 0x72, 0x79, 0x27, 0x20, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x3a, 0x20, 0x27, 0x74, 0x65, 0x78, 0x74, 0x27, 0x20, 0x6e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x20, 0x7b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x20, 0x27, 0x6e, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x27, 0x7d, 0x20, 0x64, 0x61, 0x74, 0x61, 0x3a, 0x20, 0x27, 0x72, 0x61, 0x77,
+	0x27, 0x20, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x3a, 0x20, 0x30, 0x2e, 0x35, 0x52, 0x04, 0x74, 0x65,
+	0x78, 0x74, 0x12, 0x58, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x2d, 0x9a, 0x49, 0x2a, 0x8a, 0x01, 0x27, 0x10,
+	0x00, 0x22, 0x23, 0x7b, 0x22, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x22, 0x3a, 0x20, 0x35, 0x2c, 0x20, 0x22, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x22, 0x3a,
+	0x20, 0x22, 0x32, 0x73, 0x22, 0x7d, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x12, 0x49, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x1c, 0x9a, 0x49, 0x19, 0x92, 0x01, 0x16, 0x0a, 0x14, 0x8a, 0x01, 0x11, 0x1a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x3a, 0x20, 0x31,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x4b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e,
+	0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x17, 0x9a, 0x49, 0x14, 0x8a, 0x01, 0x11, 0x1a, 0x0f, 0x6d, 0x61, 0x78,
+	0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x3a, 0x20, 0x32, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x1a, 0xf8, 0x03, 0x0a, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3c, 0x0a,
+	0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x04, 0x65,
+	0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x04, 0x65, 0x6e,
+	0x75, 0x6d, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x2a, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x26, 0x0a, 0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x06, 0x6e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x1f, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x05, 0x9a, 0x49, 0x02, 0x28, 0x0a, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x06,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x1a,
+	0x50, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x76, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x3d, 0x9a, 0x49, 0x3a, 0x8a, 0x01, 0x37, 0x1a, 0x35,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x3a, 0x20, 0x74, 0x72, 0x75, 0x65,
+	0x20, 0x5b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x5d, 0x20, 0x7b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x20, 0x27, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x27, 0x7d, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xac,
+	0x04, 0x0a, 0x09, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x07,
+	0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x9a,
+	0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x75, 0x75, 0x69, 0x64, 0x56, 0x34, 0x12,
+	0x21, 0x0a, 0x07, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x76, 0x37, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x02, 0x52, 0x06, 0x75, 0x75, 0x69, 0x64,
+	0x56, 0x37, 0x12, 0x1c, 0x0a, 0x04, 0x75, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x03, 0x52, 0x04, 0x75, 0x6c, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x03, 0x78, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x9a,
+	0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x04, 0x52, 0x03, 0x78, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x09,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x05, 0x52, 0x08, 0x6f, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x0a, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x9a, 0x49, 0x07, 0xca, 0x01, 0x04, 0x08,
+	0x06, 0x10, 0x08, 0x52, 0x09, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x48, 0x65, 0x78, 0x12, 0x24,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x07, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0a, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x09, 0x75, 0x75, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x0a,
+	0x0c, 0x72, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x06, 0x52, 0x0b, 0x72,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x0b, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08,
+	0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x08, 0x9a, 0x49, 0x05, 0xca, 0x01, 0x02, 0x08,
+	0x05, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x22, 0xe2, 0x03,
+	0x0a, 0x0a, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x73, 0x12, 0x48, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d, 0x9a, 0x49,
+	0x0a, 0xb2, 0x01, 0x07, 0x6e, 0x6f, 0x77, 0x2b, 0x33, 0x30, 0x64, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0e, 0x9a, 0x49, 0x0b, 0xb2, 0x01, 0x08, 0x6e, 0x6f,
+	0x77, 0x20, 0x2d, 0x20, 0x31, 0x68, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x3d, 0x0a, 0x05, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0x9a, 0x49,
+	0x08, 0xb2, 0x01, 0x05, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x52, 0x05, 0x74, 0x6f, 0x64, 0x61, 0x79,
+	0x12, 0x50, 0x0a, 0x08, 0x74, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x18,
+	0x9a, 0x49, 0x15, 0xb2, 0x01, 0x12, 0x74, 0x6f, 0x6d, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x20, 0x30,
+	0x30, 0x3a, 0x30, 0x30, 0x20, 0x55, 0x54, 0x43, 0x52, 0x08, 0x74, 0x6f, 0x6d, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x12, 0x54, 0x0a, 0x07, 0x6d, 0x6f, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42,
+	0x1e, 0x9a, 0x49, 0x1b, 0xb2, 0x01, 0x18, 0x74, 0x6f, 0x64, 0x61, 0x79, 0x20, 0x30, 0x38, 0x3a,
+	0x33, 0x30, 0x20, 0x45, 0x75, 0x72, 0x6f, 0x70, 0x65, 0x2f, 0x50, 0x61, 0x72, 0x69, 0x73, 0x52,
+	0x07, 0x6d, 0x6f, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x58, 0x0a, 0x07, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0x9a, 0x49, 0x1f, 0x92, 0x01, 0x1c, 0x0a, 0x0c, 0xb2,
+	0x01, 0x09, 0x79, 0x65, 0x73, 0x74, 0x65, 0x72, 0x64, 0x61, 0x79, 0x0a, 0x0c, 0xb2, 0x01, 0x09,
+	0x74, 0x6f, 0x64, 0x61, 0x79, 0x2b, 0x31, 0x32, 0x68, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x73, 0x22, 0xd6, 0x06, 0x0a, 0x03, 0x45, 0x6e, 0x76, 0x12, 0x2f, 0x0a, 0x05, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x19, 0x9a, 0x49, 0x16, 0xd2, 0x01,
+	0x13, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49,
+	0x4e, 0x54, 0x33, 0x32, 0x52, 0x05, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x36, 0x0a, 0x06, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1e, 0x9a, 0x49, 0x1b,
+	0xd2, 0x01, 0x18, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x3a, 0x2d, 0x34, 0x32, 0x52, 0x06, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x12, 0x38, 0x0a, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x10, 0x42, 0x1c, 0x9a, 0x49, 0x19, 0xd2, 0x01, 0x16, 0x44, 0x45, 0x46,
+	0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x46, 0x49, 0x58, 0x45,
+	0x44, 0x36, 0x34, 0x52, 0x08, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x35, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x1f, 0x9a, 0x49,
+	0x1c, 0xd2, 0x01, 0x19, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53,
+	0x54, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x3a, 0x2d, 0x30, 0x2e, 0x34, 0x32, 0x52, 0x05, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x18, 0x9a, 0x49, 0x15, 0xd2, 0x01, 0x12, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x4f, 0x4f, 0x4c, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x24, 0x9a, 0x49, 0x21, 0xd2, 0x01, 0x1e, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4e, 0x47, 0x3a, 0x2d,
+	0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x2f, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x19, 0x9a, 0x49, 0x16, 0xd2, 0x01, 0x13, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f,
+	0x54, 0x45, 0x53, 0x54, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x11, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x42, 0x1d, 0x9a, 0x49, 0x1a, 0xd2, 0x01, 0x17, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x4e, 0x55, 0x4d, 0x3a, 0x2d, 0x54, 0x57,
+	0x4f, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x3d, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1c, 0x9a, 0x49, 0x19, 0xd2, 0x01,
+	0x16, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x48, 0x00, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x20, 0x9a, 0x49, 0x1d, 0xd2, 0x01, 0x1a, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x3a,
+	0x2d, 0x33, 0x30, 0x73, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x59, 0x0a,
+	0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1e, 0x9a,
+	0x49, 0x1b, 0xd2, 0x01, 0x18, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x42, 0x45, 0x46, 0x4f, 0x52, 0x45, 0x52, 0x09, 0x6e,
+	0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x5d, 0x0a, 0x0b, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1f, 0x9a, 0x49, 0x1c, 0xd2,
+	0x01, 0x19, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f,
+	0x49, 0x4e, 0x54, 0x36, 0x34, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x45, 0x52, 0x0a, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x1b, 0x9a, 0x49, 0x18, 0xd2, 0x01, 0x15,
+	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x53, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0xae, 0x01, 0x0a, 0x06,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x42,
+	0x0a, 0x9a, 0x49, 0x07, 0x8a, 0x01, 0x04, 0x08, 0x01, 0x10, 0x01, 0x52, 0x03, 0x65, 0x6e, 0x76,
+	0x12, 0x35, 0x0a, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x45, 0x6e,
+	0x76, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0x9a, 0x49, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x04, 0x65, 0x6e, 0x76, 0x73, 0x1a, 0x43, 0x0a, 0x09, 0x45, 0x6e, 0x76, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x20, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x45, 0x6e,
+	0x76, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x40, 0x0a, 0x0a,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0x9a, 0x49, 0x05,
+	0x8a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x30,
+	0x5a, 0x2e, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_pb_types_proto_rawDescOnce sync.Once
+	file_tests_pb_types_proto_rawDescData = file_tests_pb_types_proto_rawDesc
+)
+
+func file_tests_pb_types_proto_rawDescGZIP() []byte {
+	file_tests_pb_types_proto_rawDescOnce.Do(func() {
+		file_tests_pb_types_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_types_proto_rawDescData)
+	})
+	return file_tests_pb_types_proto_rawDescData
+}
+
+var file_tests_pb_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_pb_types_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_tests_pb_types_proto_goTypes = []interface{}{
+	(Types_Enum)(0),                   // 0: tests.Types.Enum
+	(*Types)(nil),                     // 1: tests.Types
+	(*Message)(nil),                   // 2: tests.Message
+	(*OneOfOne)(nil),                  // 3: tests.OneOfOne
+	(*OneOfTwo)(nil),                  // 4: tests.OneOfTwo
+	(*OneOfThree)(nil),                // 5: tests.OneOfThree
+	(*Repeated)(nil),                  // 6: tests.Repeated
+	(*Maps)(nil),                      // 7: tests.Maps
+	(*Elements)(nil),                  // 8: tests.Elements
+	(*Literal)(nil),                   // 9: tests.Literal
+	(*LiteralExtension)(nil),          // 10: tests.LiteralExtension
+	(*Generated)(nil),                 // 11: tests.Generated
+	(*Timestamps)(nil),                // 12: tests.Timestamps
+	(*Env)(nil),                       // 13: tests.Env
+	(*Errors)(nil),                    // 14: tests.Errors
+	(*Initialize)(nil),                // 15: tests.Initialize
+	nil,                               // 16: tests.Maps.LabelsEntry
+	nil,                               // 17: tests.Maps.MergedEntry
+	nil,                               // 18: tests.Maps.EnumsEntry
+	nil,                               // 19: tests.Maps.DurationsEntry
+	nil,                               // 20: tests.Maps.MessagesEntry
+	nil,                               // 21: tests.Elements.ValuesEntry
+	(*Literal_Policy)(nil),            // 22: tests.Literal.Policy
+	nil,                               // 23: tests.Literal.ValuesEntry
+	nil,                               // 24: tests.Literal.Policy.WeightsEntry
+	nil,                               // 25: tests.Errors.EnvsEntry
+	(*durationpb.Duration)(nil),       // 26: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),     // 27: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),    // 28: google.protobuf.DoubleValue
+	(*wrapperspb.FloatValue)(nil),     // 29: google.protobuf.FloatValue
+	(*wrapperspb.Int64Value)(nil),     // 30: google.protobuf.Int64Value
+	(*wrapperspb.UInt64Value)(nil),    // 31: google.protobuf.UInt64Value
+	(*wrapperspb.Int32Value)(nil),     // 32: google.protobuf.Int32Value
+	(*wrapperspb.UInt32Value)(nil),    // 33: google.protobuf.UInt32Value
+	(*wrapperspb.BoolValue)(nil),      // 34: google.protobuf.BoolValue
+	(*wrapperspb.StringValue)(nil),    // 35: google.protobuf.StringValue
+	(*wrapperspb.BytesValue)(nil),     // 36: google.protobuf.BytesValue
+	(*anypb.Any)(nil),                 // 37: google.protobuf.Any
+	(*structpb.Struct)(nil),           // 38: google.protobuf.Struct
+	(*structpb.Value)(nil),            // 39: google.protobuf.Value
+	(*structpb.ListValue)(nil),        // 40: google.protobuf.ListValue
+	(*descriptorpb.FieldOptions)(nil), // 41: google.protobuf.FieldOptions
+}
+var file_tests_pb_types_proto_depIdxs = []int32{
+	0,  // 0: tests.Types.enum:type_name -> tests.Types.Enum
+	0,  // 1: tests.Types.enum_name:type_name -> tests.Types.Enum
+	0,  // 2: tests.Types.enum_full_name:type_name -> tests.Types.Enum
+	0,  // 3: tests.Types.optional_enum_name:type_name -> tests.Types.Enum
+	2,  // 4: tests.Types.message:type_name -> tests.Message
+	3,  // 5: tests.Types.one:type_name -> tests.OneOfOne
+	4,  // 6: tests.Types.two:type_name -> tests.OneOfTwo
+	5,  // 7: tests.Types.three:type_name -> tests.OneOfThree
+	0,  // 8: tests.Types.four:type_name -> tests.Types.Enum
+	26, // 9: tests.Types.duration:type_name -> google.protobuf.Duration
+	27, // 10: tests.Types.timestamp:type_name -> google.protobuf.Timestamp
+	28, // 11: tests.Types.double_value:type_name -> google.protobuf.DoubleValue
+	29, // 12: tests.Types.float_value:type_name -> google.protobuf.FloatValue
+	30, // 13: tests.Types.int64_value:type_name -> google.protobuf.Int64Value
+	31, // 14: tests.Types.uint64_value:type_name -> google.protobuf.UInt64Value
+	32, // 15: tests.Types.int32_value:type_name -> google.protobuf.Int32Value
+	33, // 16: tests.Types.uint32_value:type_name -> google.protobuf.UInt32Value
+	34, // 17: tests.Types.bool_value:type_name -> google.protobuf.BoolValue
+	35, // 18: tests.Types.string_value:type_name -> google.protobuf.StringValue
+	36, // 19: tests.Types.bytes_value:type_name -> google.protobuf.BytesValue
+	37, // 20: tests.Types.any:type_name -> google.protobuf.Any
+	37, // 21: tests.Types.any_json:type_name -> google.protobuf.Any
+	38, // 22: tests.Types.struct:type_name -> google.protobuf.Struct
+	39, // 23: tests.Types.value:type_name -> google.protobuf.Value
+	40, // 24: tests.Types.list_value:type_name -> google.protobuf.ListValue
+	0,  // 25: tests.Repeated.enums:type_name -> tests.Types.Enum
+	35, // 26: tests.Repeated.string_values:type_name -> google.protobuf.StringValue
+	26, // 27: tests.Repeated.durations:type_name -> google.protobuf.Duration
+	27, // 28: tests.Repeated.timestamps:type_name -> google.protobuf.Timestamp
+	2,  // 29: tests.Repeated.messages:type_name -> tests.Message
+	16, // 30: tests.Maps.labels:type_name -> tests.Maps.LabelsEntry
+	17, // 31: tests.Maps.merged:type_name -> tests.Maps.MergedEntry
+	18, // 32: tests.Maps.enums:type_name -> tests.Maps.EnumsEntry
+	19, // 33: tests.Maps.durations:type_name -> tests.Maps.DurationsEntry
+	20, // 34: tests.Maps.messages:type_name -> tests.Maps.MessagesEntry
+	2,  // 35: tests.Elements.messages:type_name -> tests.Message
+	2,  // 36: tests.Elements.initialized:type_name -> tests.Message
+	21, // 37: tests.Elements.values:type_name -> tests.Elements.ValuesEntry
+	22, // 38: tests.Literal.text:type_name -> tests.Literal.Policy
+	22, // 39: tests.Literal.json:type_name -> tests.Literal.Policy
+	22, // 40: tests.Literal.items:type_name -> tests.Literal.Policy
+	23, // 41: tests.Literal.values:type_name -> tests.Literal.ValuesEntry
+	41, // 42: tests.LiteralExtension.options:type_name -> google.protobuf.FieldOptions
+	35, // 43: tests.Generated.string_value:type_name -> google.protobuf.StringValue
+	36, // 44: tests.Generated.bytes_value:type_name -> google.protobuf.BytesValue
+	27, // 45: tests.Timestamps.expires_at:type_name -> google.protobuf.Timestamp
+	27, // 46: tests.Timestamps.not_before:type_name -> google.protobuf.Timestamp
+	27, // 47: tests.Timestamps.today:type_name -> google.protobuf.Timestamp
+	27, // 48: tests.Timestamps.tomorrow:type_name -> google.protobuf.Timestamp
+	27, // 49: tests.Timestamps.morning:type_name -> google.protobuf.Timestamp
+	27, // 50: tests.Timestamps.windows:type_name -> google.protobuf.Timestamp
+	0,  // 51: tests.Env.enum:type_name -> tests.Types.Enum
+	26, // 52: tests.Env.timeout:type_name -> google.protobuf.Duration
+	27, // 53: tests.Env.not_before:type_name -> google.protobuf.Timestamp
+	30, // 54: tests.Env.int64_value:type_name -> google.protobuf.Int64Value
+	13, // 55: tests.Errors.env:type_name -> tests.Env
+	25, // 56: tests.Errors.envs:type_name -> tests.Errors.EnvsEntry
+	2,  // 57: tests.Initialize.message:type_name -> tests.Message
+	0,  // 58: tests.Maps.EnumsEntry.value:type_name -> tests.Types.Enum
+	26, // 59: tests.Maps.DurationsEntry.value:type_name -> google.protobuf.Duration
+	2,  // 60: tests.Maps.MessagesEntry.value:type_name -> tests.Message
+	2,  // 61: tests.Elements.ValuesEntry.value:type_name -> tests.Message
+	26, // 62: tests.Literal.Policy.backoff:type_name -> google.protobuf.Duration
+	24, // 63: tests.Literal.Policy.weights:type_name -> tests.Literal.Policy.WeightsEntry
+	0,  // 64: tests.Literal.Policy.enum:type_name -> tests.Types.Enum
+	2,  // 65: tests.Literal.Policy.message:type_name -> tests.Message
+	2,  // 66: tests.Literal.Policy.nested:type_name -> tests.Message
+	22, // 67: tests.Literal.ValuesEntry.value:type_name -> tests.Literal.Policy
+	13, // 68: tests.Errors.EnvsEntry.value:type_name -> tests.Env
+	69, // [69:69] is the sub-list for method output_type
+	69, // [69:69] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_types_proto_init() }
+func file_tests_pb_types_proto_init() {
+	if File_tests_pb_types_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_types_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Types); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneOfOne); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneOfTwo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneOfThree); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repeated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Maps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Elements); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Literal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiteralExtension); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Generated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timestamps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Env); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Errors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Initialize); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_tests_pb_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Literal_Policy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_pb_types_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Types_One)(nil),
+		(*Types_Two)(nil),
+		(*Types_Three)(nil),
+		(*Types_Four)(nil),
+	}
+	file_tests_pb_types_proto_msgTypes[10].OneofWrappers = []interface{}{}
+	file_tests_pb_types_proto_msgTypes[12].OneofWrappers = []interface{}{}
+	file_tests_pb_types_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*Literal_Policy_Label)(nil),
+		(*Literal_Policy_Message)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -888,7 +2548,7 @@ func file_tests_pb_types_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_tests_pb_types_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   25,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,240 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-defaults. DO NOT EDIT.
+
+package pb
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+var (
+	_ *timestamppb.Timestamp
+	_ *durationpb.Duration
+	_ *wrapperspb.BoolValue
+	_ = proto.Equal
+)
+
+const (
+	Conditional_Default_Replicas uint32 = 1
+	Conditional_Default_Address  string = "remote"
+)
+
+func (x *Conditional) Default() {
+	if x.GetMode() == Conditional_CLUSTER {
+		if x.Replicas == 0 {
+			x.Replicas = 3
+		}
+	} else {
+		if x.Replicas == 0 {
+			x.Replicas = Conditional_Default_Replicas
+		}
+	}
+	if x.GetReplicas() == 3 {
+		if x.Timeout == nil {
+			x.Timeout = durationpb.New(30000000000)
+		}
+	} else if x.GetMode() == Conditional_STANDALONE {
+		if x.Timeout == nil {
+			x.Timeout = durationpb.New(5000000000)
+		}
+	}
+	if x.Endpoint == nil {
+		if x.Address == "" {
+			x.Address = "localhost"
+		}
+	} else {
+		if x.Address == "" {
+			x.Address = Conditional_Default_Address
+		}
+	}
+	if len(x.Peers) != 0 {
+		if len(x.Token) == 0 {
+			x.Token = []byte("peer")
+		}
+	}
+}
+
+func (x *Conditional) DefaultE() error {
+	var errs defaults.Errors
+	if x.GetMode() == Conditional_CLUSTER {
+		if x.Replicas == 0 {
+			x.Replicas = 3
+		}
+	} else {
+		if x.Replicas == 0 {
+			x.Replicas = Conditional_Default_Replicas
+		}
+	}
+	if x.GetReplicas() == 3 {
+		if x.Timeout == nil {
+			x.Timeout = durationpb.New(30000000000)
+		}
+	} else if x.GetMode() == Conditional_STANDALONE {
+		if x.Timeout == nil {
+			x.Timeout = durationpb.New(5000000000)
+		}
+	}
+	if x.Endpoint == nil {
+		if x.Address == "" {
+			x.Address = "localhost"
+		}
+	} else {
+		if x.Address == "" {
+			x.Address = Conditional_Default_Address
+		}
+	}
+	if len(x.Peers) != 0 {
+		if len(x.Token) == 0 {
+			x.Token = []byte("peer")
+		}
+	}
+	return errs.Err()
+}
+
+func (x *Conditional) DefaultReport() []defaults.FieldPath {
+	var paths []defaults.FieldPath
+	if x.GetMode() == Conditional_CLUSTER {
+		if x.Replicas == 0 {
+			x.Replicas = 3
+			paths = append(paths, "replicas")
+		}
+	} else {
+		if x.Replicas == 0 {
+			x.Replicas = Conditional_Default_Replicas
+			paths = append(paths, "replicas")
+		}
+	}
+	if x.GetReplicas() == 3 {
+		if x.Timeout == nil {
+			x.Timeout = durationpb.New(30000000000)
+			paths = append(paths, "timeout")
+		}
+	} else if x.GetMode() == Conditional_STANDALONE {
+		if x.Timeout == nil {
+			x.Timeout = durationpb.New(5000000000)
+			paths = append(paths, "timeout")
+		}
+	}
+	if x.Endpoint == nil {
+		if x.Address == "" {
+			x.Address = "localhost"
+			paths = append(paths, "address")
+		}
+	} else {
+		if x.Address == "" {
+			x.Address = Conditional_Default_Address
+			paths = append(paths, "address")
+		}
+	}
+	if len(x.Peers) != 0 {
+		if len(x.Token) == 0 {
+			x.Token = []byte("peer")
+			paths = append(paths, "token")
+		}
+	}
+	return paths
+}
+
+func (x *Conditional) StripDefaults() {
+	if len(x.Peers) != 0 {
+		if string(x.Token) == "peer" {
+			x.Token = nil
+		}
+	}
+	if x.Endpoint == nil {
+		if x.Address == "localhost" {
+			x.Address = ""
+		}
+	} else {
+		if x.Address == "remote" {
+			x.Address = ""
+		}
+	}
+	if x.GetReplicas() == 3 {
+		if x.Timeout != nil && proto.Equal(x.Timeout, durationpb.New(30000000000)) {
+			x.Timeout = nil
+		}
+	} else if x.GetMode() == Conditional_STANDALONE {
+		if x.Timeout != nil && proto.Equal(x.Timeout, durationpb.New(5000000000)) {
+			x.Timeout = nil
+		}
+	}
+	if x.GetMode() == Conditional_CLUSTER {
+		if x.Replicas == 3 {
+			x.Replicas = 0
+		}
+	} else {
+		if x.Replicas == 1 {
+			x.Replicas = 0
+		}
+	}
+}
+
+func (x *Conditional) IsDefault() bool {
+	if x.GetMode() == Conditional_CLUSTER {
+		if x.Replicas != 0 && x.Replicas != 3 {
+			return false
+		}
+	} else {
+		if x.Replicas != 0 && x.Replicas != 1 {
+			return false
+		}
+	}
+	if x.GetReplicas() == 3 {
+		if x.Timeout != nil && !proto.Equal(x.Timeout, durationpb.New(30000000000)) {
+			return false
+		}
+	} else if x.GetMode() == Conditional_STANDALONE {
+		if x.Timeout != nil && !proto.Equal(x.Timeout, durationpb.New(5000000000)) {
+			return false
+		}
+	}
+	if x.Endpoint == nil {
+		if x.Address != "" && x.Address != "localhost" {
+			return false
+		}
+	} else {
+		if x.Address != "" && x.Address != "remote" {
+			return false
+		}
+	}
+	if len(x.Peers) != 0 {
+		if len(x.Token) != 0 && string(x.Token) != "peer" {
+			return false
+		}
+	}
+	return true
+}
+
+func NewConditional() *Conditional {
+	x := &Conditional{}
+	x.Default()
+	return x
+}
+
+func NewConditionalWith(fn func(x *Conditional)) *Conditional {
+	x := &Conditional{}
+	if fn != nil {
+		fn(x)
+	}
+	x.Default()
+	return x
+}
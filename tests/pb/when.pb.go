@@ -0,0 +1,296 @@
+// Copyright 2021 Linka Cloud  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: tests/pb/when.proto
+
+package pb
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+
+	_ "go.linka.cloud/protoc-gen-defaults/defaults"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Conditional_Mode int32
+
+const (
+	Conditional_MODE_UNSPECIFIED Conditional_Mode = 0
+	Conditional_STANDALONE       Conditional_Mode = 1
+	Conditional_CLUSTER          Conditional_Mode = 2
+)
+
+// Enum value maps for Conditional_Mode.
+var (
+	Conditional_Mode_name = map[int32]string{
+		0: "MODE_UNSPECIFIED",
+		1: "STANDALONE",
+		2: "CLUSTER",
+	}
+	Conditional_Mode_value = map[string]int32{
+		"MODE_UNSPECIFIED": 0,
+		"STANDALONE":       1,
+		"CLUSTER":          2,
+	}
+)
+
+func (x Conditional_Mode) Enum() *Conditional_Mode {
+	p := new(Conditional_Mode)
+	*p = x
+	return p
+}
+
+func (x Conditional_Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Conditional_Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_tests_pb_when_proto_enumTypes[0].Descriptor()
+}
+
+func (Conditional_Mode) Type() protoreflect.EnumType {
+	return &file_tests_pb_when_proto_enumTypes[0]
+}
+
+func (x Conditional_Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Conditional_Mode.Descriptor instead.
+func (Conditional_Mode) EnumDescriptor() ([]byte, []int) {
+	return file_tests_pb_when_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type Conditional struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Mode     Conditional_Mode     `protobuf:"varint,1,opt,name=mode,proto3,enum=tests.Conditional_Mode" json:"mode,omitempty"`
+	Replicas uint32               `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Timeout  *durationpb.Duration `protobuf:"bytes,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Endpoint *string              `protobuf:"bytes,4,opt,name=endpoint,proto3,oneof" json:"endpoint,omitempty"`
+	Address  string               `protobuf:"bytes,5,opt,name=address,proto3" json:"address,omitempty"`
+	Peers    []string             `protobuf:"bytes,6,rep,name=peers,proto3" json:"peers,omitempty"`
+	Token    []byte               `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *Conditional) Reset() {
+	*x = Conditional{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_tests_pb_when_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Conditional) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Conditional) ProtoMessage() {}
+
+func (x *Conditional) ProtoReflect() protoreflect.Message {
+	mi := &file_tests_pb_when_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Conditional.ProtoReflect.Descriptor instead.
+func (*Conditional) Descriptor() ([]byte, []int) {
+	return file_tests_pb_when_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Conditional) GetMode() Conditional_Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Conditional_MODE_UNSPECIFIED
+}
+
+func (x *Conditional) GetReplicas() uint32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *Conditional) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Conditional) GetEndpoint() string {
+	if x != nil && x.Endpoint != nil {
+		return *x.Endpoint
+	}
+	return ""
+}
+
+func (x *Conditional) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Conditional) GetPeers() []string {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *Conditional) GetToken() []byte {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+var File_tests_pb_when_proto protoreflect.FileDescriptor
+
+var file_tests_pb_when_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x77, 0x68, 0x65, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x17, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x03, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1b, 0x9a, 0x49, 0x02, 0x28, 0x01, 0xa2, 0x49, 0x13, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x22, 0x02, 0x28, 0x03, 0x12, 0x07, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45,
+	0x52, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x69, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x34, 0xa2, 0x49, 0x15, 0x0a, 0x08, 0x72, 0x65,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x22, 0x06, 0xaa, 0x01, 0x03, 0x33, 0x30, 0x73, 0x12, 0x01,
+	0x33, 0xa2, 0x49, 0x19, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x05, 0xaa, 0x01, 0x02, 0x35,
+	0x73, 0x12, 0x0a, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x9a, 0x49, 0x08, 0x72, 0x06, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0xa2, 0x49, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x22, 0x0b, 0x72, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x14, 0xa2, 0x49, 0x11, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x06, 0x7a, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x18, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x39, 0x0a, 0x04,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54,
+	0x41, 0x4e, 0x44, 0x41, 0x4c, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c,
+	0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x02, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x6f, 0x2e, 0x6c, 0x69, 0x6e, 0x6b, 0x61,
+	0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65,
+	0x6e, 0x2d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73,
+	0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_tests_pb_when_proto_rawDescOnce sync.Once
+	file_tests_pb_when_proto_rawDescData = file_tests_pb_when_proto_rawDesc
+)
+
+func file_tests_pb_when_proto_rawDescGZIP() []byte {
+	file_tests_pb_when_proto_rawDescOnce.Do(func() {
+		file_tests_pb_when_proto_rawDescData = protoimpl.X.CompressGZIP(file_tests_pb_when_proto_rawDescData)
+	})
+	return file_tests_pb_when_proto_rawDescData
+}
+
+var file_tests_pb_when_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_tests_pb_when_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_tests_pb_when_proto_goTypes = []interface{}{
+	(Conditional_Mode)(0),       // 0: tests.Conditional.Mode
+	(*Conditional)(nil),         // 1: tests.Conditional
+	(*durationpb.Duration)(nil), // 2: google.protobuf.Duration
+}
+var file_tests_pb_when_proto_depIdxs = []int32{
+	0, // 0: tests.Conditional.mode:type_name -> tests.Conditional.Mode
+	2, // 1: tests.Conditional.timeout:type_name -> google.protobuf.Duration
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_tests_pb_when_proto_init() }
+func file_tests_pb_when_proto_init() {
+	if File_tests_pb_when_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_tests_pb_when_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Conditional); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_tests_pb_when_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_tests_pb_when_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_tests_pb_when_proto_goTypes,
+		DependencyIndexes: file_tests_pb_when_proto_depIdxs,
+		EnumInfos:         file_tests_pb_when_proto_enumTypes,
+		MessageInfos:      file_tests_pb_when_proto_msgTypes,
+	}.Build()
+	File_tests_pb_when_proto = out.File
+	file_tests_pb_when_proto_rawDesc = nil
+	file_tests_pb_when_proto_goTypes = nil
+	file_tests_pb_when_proto_depIdxs = nil
+}